@@ -38,7 +38,11 @@ type (
 	AbstractionLike           = ast.AbstractionLike
 	AdditionalArgumentLike    = ast.AdditionalArgumentLike
 	AdditionalConstraintLike  = ast.AdditionalConstraintLike
+	AdditionalExpressionLike  = ast.AdditionalExpressionLike
+	AdditionalOutputLike      = ast.AdditionalOutputLike
+	AdditionalTermLike        = ast.AdditionalTermLike
 	AdditionalValueLike       = ast.AdditionalValueLike
+	AliasLike                 = ast.AliasLike
 	ArgumentLike              = ast.ArgumentLike
 	ArgumentsLike             = ast.ArgumentsLike
 	ArrayLike                 = ast.ArrayLike
@@ -53,7 +57,9 @@ type (
 	ClassDefinitionLike       = ast.ClassDefinitionLike
 	ClassMethodsLike          = ast.ClassMethodsLike
 	ClassSectionLike          = ast.ClassSectionLike
+	ConstantDefinitionLike    = ast.ConstantDefinitionLike
 	ConstantMethodLike        = ast.ConstantMethodLike
+	ConstantSectionLike       = ast.ConstantSectionLike
 	ConstantSubsectionLike    = ast.ConstantSubsectionLike
 	ConstraintLike            = ast.ConstraintLike
 	ConstraintsLike           = ast.ConstraintsLike
@@ -61,6 +67,9 @@ type (
 	ConstructorSubsectionLike = ast.ConstructorSubsectionLike
 	DeclarationLike           = ast.DeclarationLike
 	EnumerationLike           = ast.EnumerationLike
+	ExpressionLike            = ast.ExpressionLike
+	FieldLike                 = ast.FieldLike
+	FunctionLike              = ast.FunctionLike
 	FunctionMethodLike        = ast.FunctionMethodLike
 	FunctionSubsectionLike    = ast.FunctionSubsectionLike
 	FunctionalDefinitionLike  = ast.FunctionalDefinitionLike
@@ -68,10 +77,13 @@ type (
 	GetterMethodLike          = ast.GetterMethodLike
 	HeaderLike                = ast.HeaderLike
 	ImportsLike               = ast.ImportsLike
+	InitializerLike           = ast.InitializerLike
 	InstanceDefinitionLike    = ast.InstanceDefinitionLike
 	InstanceMethodsLike       = ast.InstanceMethodsLike
 	InstanceSectionLike       = ast.InstanceSectionLike
 	InterfaceDefinitionsLike  = ast.InterfaceDefinitionsLike
+	InvocationLike            = ast.InvocationLike
+	LocationLike              = ast.LocationLike
 	MapLike                   = ast.MapLike
 	MethodLike                = ast.MethodLike
 	ModelLike                 = ast.ModelLike
@@ -79,27 +91,55 @@ type (
 	ModuleDefinitionLike      = ast.ModuleDefinitionLike
 	NoneLike                  = ast.NoneLike
 	NoticeLike                = ast.NoticeLike
+	OperandLike               = ast.OperandLike
+	OperationLike             = ast.OperationLike
+	OperatorLike              = ast.OperatorLike
+	OutputLike                = ast.OutputLike
 	ParameterLike             = ast.ParameterLike
 	ParameterizedLike         = ast.ParameterizedLike
+	PointerLike               = ast.PointerLike
+	PrecedenceLike            = ast.PrecedenceLike
 	PrefixLike                = ast.PrefixLike
 	PrimitiveDefinitionsLike  = ast.PrimitiveDefinitionsLike
 	PublicMethodLike          = ast.PublicMethodLike
 	PublicSubsectionLike      = ast.PublicSubsectionLike
+	QualifierLike             = ast.QualifierLike
+	ReferenceLike             = ast.ReferenceLike
 	ResultLike                = ast.ResultLike
 	SetterMethodLike          = ast.SetterMethodLike
+	SizeLike                  = ast.SizeLike
+	SpanLike                  = ast.SpanLike
+	StructureLike             = ast.StructureLike
 	SuffixLike                = ast.SuffixLike
+	TermLike                  = ast.TermLike
+	TupleLike                 = ast.TupleLike
 	TypeDefinitionLike        = ast.TypeDefinitionLike
 	TypeSectionLike           = ast.TypeSectionLike
+	UnaryLike                 = ast.UnaryLike
+	UnderlyingLike            = ast.UnderlyingLike
 	ValueLike                 = ast.ValueLike
+
+	Spanned = ast.Spanned
 )
 
 // Grammar
 
 type (
-	FormatterLike = gra.FormatterLike
-	ParserLike    = gra.ParserLike
-	ValidatorLike = gra.ValidatorLike
-	VisitorLike   = gra.VisitorLike
+	CrossReferenceLike  = gra.CrossReferenceLike
+	FormatterLike       = gra.FormatterLike
+	ParserLike          = gra.ParserLike
+	ResolutionErrorLike = gra.ResolutionErrorLike
+	ResolverLike        = gra.ResolverLike
+	SymbolKind          = gra.SymbolKind
+	SymbolLike          = gra.SymbolLike
+	SymbolTableLike     = gra.SymbolTableLike
+	SyntaxErrorLike     = gra.SyntaxErrorLike
+	TokenLike           = gra.TokenLike
+	TokenType           = gra.TokenType
+	UsageLike           = gra.UsageLike
+	ValidatorLike       = gra.ValidatorLike
+	VisitorLike         = gra.VisitorLike
+	WorkspaceLike       = gra.WorkspaceLike
 
 	Methodical = gra.Methodical
 )
@@ -168,9 +208,29 @@ func Visitor(args ...any) VisitorLike {
 // Generator
 
 func Classes(args ...any) ClassesLike {
-	if len(args) > 0 {
-		panic("The \"classes\" constructor does not take any arguments.")
+	// Initialize the possible arguments.
+	var workspace WorkspaceLike
+
+	// Process the actual arguments.
+	for _, arg := range args {
+		switch actual := arg.(type) {
+		case WorkspaceLike:
+			workspace = actual
+		default:
+			if uti.IsDefined(arg) {
+				var message = fmt.Sprintf(
+					"An unknown argument type was passed into the \"classes\" constructor: %T\n",
+					actual,
+				)
+				panic(message)
+			}
+		}
 	}
+
+	// Call the constructor.
 	var classes = gen.Classes().Make()
+	if uti.IsDefined(workspace) {
+		classes = gen.Classes().MakeWithWorkspace(workspace)
+	}
 	return classes
 }
@@ -56,6 +56,18 @@ func TestRoundTrips(t *tes.T) {
 				panic(err)
 			}
 		}
+		var structures = generator.GenerateModelStructures(model).GetIterator()
+		for structures.HasNext() {
+			var association = structures.GetNext()
+			var structureName = association.GetKey()
+			var structureSource = association.GetValue()
+			bytes = []byte(structureSource)
+			var filename = directory + structureName + ".go"
+			err = osx.WriteFile(filename, bytes, 0644)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 	fmt.Println("Done.")
 }
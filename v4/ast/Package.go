@@ -33,6 +33,20 @@ import (
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 )
 
+// Type Definitions
+
+/*
+Direction is a constrained type representing the direction in which values may
+flow through a channel.
+*/
+type Direction uint8
+
+const (
+	Bidirectional Direction = iota
+	Receive
+	Send
+)
+
 // Class Definitions
 
 /*
@@ -43,11 +57,15 @@ concrete abstraction-like class.
 type AbstractionClassLike interface {
 	// Constructor Methods
 	Make(
-		optionalPrefix PrefixLike,
+		prefixes abs.Sequential[PrefixLike],
 		name string,
 		optionalSuffix SuffixLike,
 		optionalArguments ArgumentsLike,
 	) AbstractionLike
+	MakeWithFunction(
+		prefixes abs.Sequential[PrefixLike],
+		function FunctionLike,
+	) AbstractionLike
 }
 
 /*
@@ -74,6 +92,42 @@ type AdditionalConstraintClassLike interface {
 	) AdditionalConstraintLike
 }
 
+/*
+AdditionalExpressionClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete additional-expression-like class.
+*/
+type AdditionalExpressionClassLike interface {
+	// Constructor Methods
+	Make(
+		expression ExpressionLike,
+	) AdditionalExpressionLike
+}
+
+/*
+AdditionalOutputClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete additional-output-like class.
+*/
+type AdditionalOutputClassLike interface {
+	// Constructor Methods
+	Make(
+		output OutputLike,
+	) AdditionalOutputLike
+}
+
+/*
+AdditionalTermClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete additional-term-like class.
+*/
+type AdditionalTermClassLike interface {
+	// Constructor Methods
+	Make(
+		term TermLike,
+	) AdditionalTermLike
+}
+
 /*
 AdditionalValueClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -83,9 +137,23 @@ type AdditionalValueClassLike interface {
 	// Constructor Methods
 	Make(
 		name string,
+		optionalAbstraction AbstractionLike,
+		optionalInitializer InitializerLike,
 	) AdditionalValueLike
 }
 
+/*
+AliasClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete alias-like class.
+*/
+type AliasClassLike interface {
+	// Constructor Methods
+	Make(
+		any_ any,
+	) AliasLike
+}
+
 /*
 ArgumentClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -118,7 +186,9 @@ concrete array-like class.
 */
 type ArrayClassLike interface {
 	// Constructor Methods
-	Make() ArrayLike
+	Make(
+		optionalSize SizeLike,
+	) ArrayLike
 }
 
 /*
@@ -130,6 +200,7 @@ type AspectDefinitionClassLike interface {
 	// Constructor Methods
 	Make(
 		declaration DeclarationLike,
+		aspectInterfaces abs.Sequential[AspectInterfaceLike],
 		aspectMethods abs.Sequential[AspectMethodLike],
 	) AspectDefinitionLike
 }
@@ -213,7 +284,9 @@ concrete channel-like class.
 */
 type ChannelClassLike interface {
 	// Constructor Methods
-	Make() ChannelLike
+	Make(
+		direction Direction,
+	) ChannelLike
 }
 
 /*
@@ -255,6 +328,22 @@ type ClassSectionClassLike interface {
 	) ClassSectionLike
 }
 
+/*
+ConstantDefinitionClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete constant-definition-like class.
+*/
+type ConstantDefinitionClassLike interface {
+	// Constructor Methods
+	Make(
+		comment string,
+		qualifier QualifierLike,
+		name string,
+		optionalAbstraction AbstractionLike,
+		initializer InitializerLike,
+	) ConstantDefinitionLike
+}
+
 /*
 ConstantMethodClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -263,11 +352,24 @@ concrete constant-method-like class.
 type ConstantMethodClassLike interface {
 	// Constructor Methods
 	Make(
+		optionalNote string,
 		name string,
 		abstraction AbstractionLike,
 	) ConstantMethodLike
 }
 
+/*
+ConstantSectionClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete constant-section-like class.
+*/
+type ConstantSectionClassLike interface {
+	// Constructor Methods
+	Make(
+		constantDefinitions abs.Sequential[ConstantDefinitionLike],
+	) ConstantSectionLike
+}
+
 /*
 ConstantSubsectionClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -289,7 +391,8 @@ type ConstraintClassLike interface {
 	// Constructor Methods
 	Make(
 		name string,
-		abstraction AbstractionLike,
+		term TermLike,
+		additionalTerms abs.Sequential[AdditionalTermLike],
 	) ConstraintLike
 }
 
@@ -314,6 +417,7 @@ concrete constructor-method-like class.
 type ConstructorMethodClassLike interface {
 	// Constructor Methods
 	Make(
+		optionalNote string,
 		name string,
 		parameters abs.Sequential[ParameterLike],
 		abstraction AbstractionLike,
@@ -359,6 +463,47 @@ type EnumerationClassLike interface {
 	) EnumerationLike
 }
 
+/*
+ExpressionClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete expression-like class.
+*/
+type ExpressionClassLike interface {
+	// Constructor Methods
+	Make(
+		operand OperandLike,
+		operations abs.Sequential[OperationLike],
+	) ExpressionLike
+}
+
+/*
+FieldClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete field-like class.
+*/
+type FieldClassLike interface {
+	// Constructor Methods
+	Make(
+		name string,
+		abstraction AbstractionLike,
+		optionalTag string,
+	) FieldLike
+}
+
+/*
+FunctionClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete function-like class.
+*/
+type FunctionClassLike interface {
+	// Constructor Methods
+	Make(
+		optionalArgument ArgumentLike,
+		additionalArguments abs.Sequential[AdditionalArgumentLike],
+		optionalResult ResultLike,
+	) FunctionLike
+}
+
 /*
 FunctionMethodClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -367,6 +512,7 @@ concrete function-method-like class.
 type FunctionMethodClassLike interface {
 	// Constructor Methods
 	Make(
+		optionalNote string,
 		name string,
 		parameters abs.Sequential[ParameterLike],
 		result ResultLike,
@@ -419,6 +565,7 @@ concrete getter-method-like class.
 type GetterMethodClassLike interface {
 	// Constructor Methods
 	Make(
+		optionalNote string,
 		name string,
 		abstraction AbstractionLike,
 	) GetterMethodLike
@@ -449,6 +596,18 @@ type ImportsClassLike interface {
 	) ImportsLike
 }
 
+/*
+InitializerClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete initializer-like class.
+*/
+type InitializerClassLike interface {
+	// Constructor Methods
+	Make(
+		expression ExpressionLike,
+	) InitializerLike
+}
+
 /*
 InstanceDefinitionClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -502,6 +661,33 @@ type InterfaceDefinitionsClassLike interface {
 	) InterfaceDefinitionsLike
 }
 
+/*
+InvocationClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete invocation-like class.
+*/
+type InvocationClassLike interface {
+	// Constructor Methods
+	Make(
+		optionalExpression ExpressionLike,
+		additionalExpressions abs.Sequential[AdditionalExpressionLike],
+	) InvocationLike
+}
+
+/*
+LocationClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete location-like class.
+*/
+type LocationClassLike interface {
+	// Constructor Methods
+	Make(
+		line uint,
+		column uint,
+		offset uint,
+	) LocationLike
+}
+
 /*
 MapClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -522,6 +708,7 @@ concrete method-like class.
 type MethodClassLike interface {
 	// Constructor Methods
 	Make(
+		optionalNote string,
 		name string,
 		parameters abs.Sequential[ParameterLike],
 		optionalResult ResultLike,
@@ -550,7 +737,7 @@ concrete module-like class.
 type ModuleClassLike interface {
 	// Constructor Methods
 	Make(
-		name string,
+		optionalAlias AliasLike,
 		path string,
 	) ModuleLike
 }
@@ -593,6 +780,56 @@ type NoticeClassLike interface {
 	) NoticeLike
 }
 
+/*
+OperandClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete operand-like class.
+*/
+type OperandClassLike interface {
+	// Constructor Methods
+	Make(
+		any_ any,
+	) OperandLike
+}
+
+/*
+OperationClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete operation-like class.
+*/
+type OperationClassLike interface {
+	// Constructor Methods
+	Make(
+		operator OperatorLike,
+		operand OperandLike,
+	) OperationLike
+}
+
+/*
+OperatorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete operator-like class.
+*/
+type OperatorClassLike interface {
+	// Constructor Methods
+	Make(
+		any_ any,
+	) OperatorLike
+}
+
+/*
+OutputClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete output-like class.
+*/
+type OutputClassLike interface {
+	// Constructor Methods
+	Make(
+		name string,
+		abstraction AbstractionLike,
+	) OutputLike
+}
+
 /*
 ParameterClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -602,6 +839,7 @@ type ParameterClassLike interface {
 	// Constructor Methods
 	Make(
 		name string,
+		variadic bool,
 		abstraction AbstractionLike,
 	) ParameterLike
 }
@@ -614,10 +852,33 @@ concrete parameterized-like class.
 type ParameterizedClassLike interface {
 	// Constructor Methods
 	Make(
-		parameters abs.Sequential[ParameterLike],
+		output OutputLike,
+		additionalOutputs abs.Sequential[AdditionalOutputLike],
 	) ParameterizedLike
 }
 
+/*
+PointerClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete pointer-like class.
+*/
+type PointerClassLike interface {
+	// Constructor Methods
+	Make() PointerLike
+}
+
+/*
+PrecedenceClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete precedence-like class.
+*/
+type PrecedenceClassLike interface {
+	// Constructor Methods
+	Make(
+		expression ExpressionLike,
+	) PrecedenceLike
+}
+
 /*
 PrefixClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -640,6 +901,7 @@ type PrimitiveDefinitionsClassLike interface {
 	Make(
 		optionalTypeSection TypeSectionLike,
 		optionalFunctionalSection FunctionalSectionLike,
+		optionalConstantSection ConstantSectionLike,
 	) PrimitiveDefinitionsLike
 }
 
@@ -667,6 +929,32 @@ type PublicSubsectionClassLike interface {
 	) PublicSubsectionLike
 }
 
+/*
+QualifierClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete qualifier-like class.
+*/
+type QualifierClassLike interface {
+	// Constructor Methods
+	Make(
+		any_ any,
+	) QualifierLike
+}
+
+/*
+ReferenceClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete reference-like class.
+*/
+type ReferenceClassLike interface {
+	// Constructor Methods
+	Make(
+		name string,
+		optionalSuffix SuffixLike,
+		optionalInvocation InvocationLike,
+	) ReferenceLike
+}
+
 /*
 ResultClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -687,11 +975,49 @@ concrete setter-method-like class.
 type SetterMethodClassLike interface {
 	// Constructor Methods
 	Make(
+		optionalNote string,
 		name string,
 		parameter ParameterLike,
 	) SetterMethodLike
 }
 
+/*
+SizeClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete size-like class.
+*/
+type SizeClassLike interface {
+	// Constructor Methods
+	Make(
+		any_ any,
+	) SizeLike
+}
+
+/*
+SpanClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete span-like class.
+*/
+type SpanClassLike interface {
+	// Constructor Methods
+	Make(
+		start LocationLike,
+		end LocationLike,
+	) SpanLike
+}
+
+/*
+StructureClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete structure-like class.
+*/
+type StructureClassLike interface {
+	// Constructor Methods
+	Make(
+		fields abs.Sequential[FieldLike],
+	) StructureLike
+}
+
 /*
 SuffixClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -704,6 +1030,32 @@ type SuffixClassLike interface {
 	) SuffixLike
 }
 
+/*
+TermClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete term-like class.
+*/
+type TermClassLike interface {
+	// Constructor Methods
+	Make(
+		approximate bool,
+		abstraction AbstractionLike,
+	) TermLike
+}
+
+/*
+TupleClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete tuple-like class.
+*/
+type TupleClassLike interface {
+	// Constructor Methods
+	Make(
+		argument ArgumentLike,
+		additionalArguments abs.Sequential[AdditionalArgumentLike],
+	) TupleLike
+}
+
 /*
 TypeDefinitionClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -713,7 +1065,7 @@ type TypeDefinitionClassLike interface {
 	// Constructor Methods
 	Make(
 		declaration DeclarationLike,
-		abstraction AbstractionLike,
+		underlying UnderlyingLike,
 		optionalEnumeration EnumerationLike,
 	) TypeDefinitionLike
 }
@@ -730,6 +1082,31 @@ type TypeSectionClassLike interface {
 	) TypeSectionLike
 }
 
+/*
+UnaryClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete unary-like class.
+*/
+type UnaryClassLike interface {
+	// Constructor Methods
+	Make(
+		operator string,
+		operand OperandLike,
+	) UnaryLike
+}
+
+/*
+UnderlyingClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete underlying-like class.
+*/
+type UnderlyingClassLike interface {
+	// Constructor Methods
+	Make(
+		any_ any,
+	) UnderlyingLike
+}
+
 /*
 ValueClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -740,6 +1117,7 @@ type ValueClassLike interface {
 	Make(
 		name string,
 		abstraction AbstractionLike,
+		initializer InitializerLike,
 	) ValueLike
 }
 
@@ -755,10 +1133,14 @@ type AbstractionLike interface {
 	GetClass() AbstractionClassLike
 
 	// Attribute Methods
-	GetOptionalPrefix() PrefixLike
+	GetPrefixes() abs.Sequential[PrefixLike]
+	GetOptionalFunction() FunctionLike
 	GetName() string
 	GetOptionalSuffix() SuffixLike
 	GetOptionalArguments() ArgumentsLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -772,6 +1154,9 @@ type AdditionalArgumentLike interface {
 
 	// Attribute Methods
 	GetArgument() ArgumentLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -785,60 +1170,144 @@ type AdditionalConstraintLike interface {
 
 	// Attribute Methods
 	GetConstraint() ConstraintLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
-AdditionalValueLike is an instance interface that defines the complete set of
+AdditionalExpressionLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
-instance of a concrete additional-value-like class.
+instance of a concrete additional-expression-like class.
 */
-type AdditionalValueLike interface {
+type AdditionalExpressionLike interface {
 	// Public Methods
-	GetClass() AdditionalValueClassLike
+	GetClass() AdditionalExpressionClassLike
 
 	// Attribute Methods
-	GetName() string
+	GetExpression() ExpressionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
-ArgumentLike is an instance interface that defines the complete set of
+AdditionalOutputLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
-instance of a concrete argument-like class.
+instance of a concrete additional-output-like class.
 */
-type ArgumentLike interface {
+type AdditionalOutputLike interface {
 	// Public Methods
-	GetClass() ArgumentClassLike
+	GetClass() AdditionalOutputClassLike
 
 	// Attribute Methods
-	GetAbstraction() AbstractionLike
+	GetOutput() OutputLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
-ArgumentsLike is an instance interface that defines the complete set of
+AdditionalTermLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
-instance of a concrete arguments-like class.
+instance of a concrete additional-term-like class.
 */
-type ArgumentsLike interface {
+type AdditionalTermLike interface {
 	// Public Methods
-	GetClass() ArgumentsClassLike
+	GetClass() AdditionalTermClassLike
 
 	// Attribute Methods
-	GetArgument() ArgumentLike
-	GetAdditionalArguments() abs.Sequential[AdditionalArgumentLike]
+	GetTerm() TermLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
-ArrayLike is an instance interface that defines the complete set of
+AdditionalValueLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
-instance of a concrete array-like class.
+instance of a concrete additional-value-like class.
 */
-type ArrayLike interface {
+type AdditionalValueLike interface {
 	// Public Methods
-	GetClass() ArrayClassLike
+	GetClass() AdditionalValueClassLike
+
+	// Attribute Methods
+	GetName() string
+	GetOptionalAbstraction() AbstractionLike
+	GetOptionalInitializer() InitializerLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
-AspectDefinitionLike is an instance interface that defines the complete set of
+AliasLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete alias-like class.
+*/
+type AliasLike interface {
+	// Public Methods
+	GetClass() AliasClassLike
+
+	// Attribute Methods
+	GetAny() any
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+ArgumentLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete argument-like class.
+*/
+type ArgumentLike interface {
+	// Public Methods
+	GetClass() ArgumentClassLike
+
+	// Attribute Methods
+	GetAbstraction() AbstractionLike
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+ArgumentsLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete arguments-like class.
+*/
+type ArgumentsLike interface {
+	// Public Methods
+	GetClass() ArgumentsClassLike
+
+	// Attribute Methods
+	GetArgument() ArgumentLike
+	GetAdditionalArguments() abs.Sequential[AdditionalArgumentLike]
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+ArrayLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete array-like class.
+*/
+type ArrayLike interface {
+	// Public Methods
+	GetClass() ArrayClassLike
+
+	// Attribute Methods
+	GetOptionalSize() SizeLike
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+AspectDefinitionLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
 instance of a concrete aspect-definition-like class.
 */
@@ -848,7 +1317,11 @@ type AspectDefinitionLike interface {
 
 	// Attribute Methods
 	GetDeclaration() DeclarationLike
+	GetAspectInterfaces() abs.Sequential[AspectInterfaceLike]
 	GetAspectMethods() abs.Sequential[AspectMethodLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -862,6 +1335,9 @@ type AspectInterfaceLike interface {
 
 	// Attribute Methods
 	GetAbstraction() AbstractionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -875,6 +1351,9 @@ type AspectMethodLike interface {
 
 	// Attribute Methods
 	GetMethod() MethodLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -888,6 +1367,9 @@ type AspectSectionLike interface {
 
 	// Attribute Methods
 	GetAspectDefinitions() abs.Sequential[AspectDefinitionLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -901,6 +1383,9 @@ type AspectSubsectionLike interface {
 
 	// Attribute Methods
 	GetAspectInterfaces() abs.Sequential[AspectInterfaceLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -914,6 +1399,9 @@ type AttributeMethodLike interface {
 
 	// Attribute Methods
 	GetAny() any
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -927,6 +1415,9 @@ type AttributeSubsectionLike interface {
 
 	// Attribute Methods
 	GetAttributeMethods() abs.Sequential[AttributeMethodLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -937,6 +1428,12 @@ instance of a concrete channel-like class.
 type ChannelLike interface {
 	// Public Methods
 	GetClass() ChannelClassLike
+
+	// Attribute Methods
+	GetDirection() Direction
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -951,6 +1448,9 @@ type ClassDefinitionLike interface {
 	// Attribute Methods
 	GetDeclaration() DeclarationLike
 	GetClassMethods() ClassMethodsLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -966,6 +1466,9 @@ type ClassMethodsLike interface {
 	GetConstructorSubsection() ConstructorSubsectionLike
 	GetOptionalConstantSubsection() ConstantSubsectionLike
 	GetOptionalFunctionSubsection() FunctionSubsectionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -979,6 +1482,29 @@ type ClassSectionLike interface {
 
 	// Attribute Methods
 	GetClassDefinitions() abs.Sequential[ClassDefinitionLike]
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+ConstantDefinitionLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete constant-definition-like class.
+*/
+type ConstantDefinitionLike interface {
+	// Public Methods
+	GetClass() ConstantDefinitionClassLike
+
+	// Attribute Methods
+	GetComment() string
+	GetQualifier() QualifierLike
+	GetName() string
+	GetOptionalAbstraction() AbstractionLike
+	GetInitializer() InitializerLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -991,8 +1517,28 @@ type ConstantMethodLike interface {
 	GetClass() ConstantMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetAbstraction() AbstractionLike
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+ConstantSectionLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete constant-section-like class.
+*/
+type ConstantSectionLike interface {
+	// Public Methods
+	GetClass() ConstantSectionClassLike
+
+	// Attribute Methods
+	GetConstantDefinitions() abs.Sequential[ConstantDefinitionLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1006,6 +1552,9 @@ type ConstantSubsectionLike interface {
 
 	// Attribute Methods
 	GetConstantMethods() abs.Sequential[ConstantMethodLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1019,7 +1568,11 @@ type ConstraintLike interface {
 
 	// Attribute Methods
 	GetName() string
-	GetAbstraction() AbstractionLike
+	GetTerm() TermLike
+	GetAdditionalTerms() abs.Sequential[AdditionalTermLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1034,6 +1587,9 @@ type ConstraintsLike interface {
 	// Attribute Methods
 	GetConstraint() ConstraintLike
 	GetAdditionalConstraints() abs.Sequential[AdditionalConstraintLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1046,9 +1602,13 @@ type ConstructorMethodLike interface {
 	GetClass() ConstructorMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetParameters() abs.Sequential[ParameterLike]
 	GetAbstraction() AbstractionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1062,6 +1622,9 @@ type ConstructorSubsectionLike interface {
 
 	// Attribute Methods
 	GetConstructorMethods() abs.Sequential[ConstructorMethodLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1077,6 +1640,9 @@ type DeclarationLike interface {
 	GetComment() string
 	GetName() string
 	GetOptionalConstraints() ConstraintsLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1091,6 +1657,62 @@ type EnumerationLike interface {
 	// Attribute Methods
 	GetValue() ValueLike
 	GetAdditionalValues() abs.Sequential[AdditionalValueLike]
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+ExpressionLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete expression-like class.
+*/
+type ExpressionLike interface {
+	// Public Methods
+	GetClass() ExpressionClassLike
+
+	// Attribute Methods
+	GetOperand() OperandLike
+	GetOperations() abs.Sequential[OperationLike]
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+FieldLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete field-like class.
+*/
+type FieldLike interface {
+	// Public Methods
+	GetClass() FieldClassLike
+
+	// Attribute Methods
+	GetName() string
+	GetAbstraction() AbstractionLike
+	GetOptionalTag() string
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+FunctionLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete function-like class.
+*/
+type FunctionLike interface {
+	// Public Methods
+	GetClass() FunctionClassLike
+
+	// Attribute Methods
+	GetOptionalArgument() ArgumentLike
+	GetAdditionalArguments() abs.Sequential[AdditionalArgumentLike]
+	GetOptionalResult() ResultLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1103,9 +1725,13 @@ type FunctionMethodLike interface {
 	GetClass() FunctionMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetParameters() abs.Sequential[ParameterLike]
 	GetResult() ResultLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1119,6 +1745,9 @@ type FunctionSubsectionLike interface {
 
 	// Attribute Methods
 	GetFunctionMethods() abs.Sequential[FunctionMethodLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1134,6 +1763,9 @@ type FunctionalDefinitionLike interface {
 	GetDeclaration() DeclarationLike
 	GetParameters() abs.Sequential[ParameterLike]
 	GetResult() ResultLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1147,6 +1779,9 @@ type FunctionalSectionLike interface {
 
 	// Attribute Methods
 	GetFunctionalDefinitions() abs.Sequential[FunctionalDefinitionLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1159,8 +1794,12 @@ type GetterMethodLike interface {
 	GetClass() GetterMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetAbstraction() AbstractionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1175,6 +1814,9 @@ type HeaderLike interface {
 	// Attribute Methods
 	GetComment() string
 	GetName() string
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1188,6 +1830,25 @@ type ImportsLike interface {
 
 	// Attribute Methods
 	GetModules() abs.Sequential[ModuleLike]
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+InitializerLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete initializer-like class.
+*/
+type InitializerLike interface {
+	// Public Methods
+	GetClass() InitializerClassLike
+
+	// Attribute Methods
+	GetExpression() ExpressionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1202,6 +1863,9 @@ type InstanceDefinitionLike interface {
 	// Attribute Methods
 	GetDeclaration() DeclarationLike
 	GetInstanceMethods() InstanceMethodsLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1217,6 +1881,9 @@ type InstanceMethodsLike interface {
 	GetPublicSubsection() PublicSubsectionLike
 	GetOptionalAttributeSubsection() AttributeSubsectionLike
 	GetOptionalAspectSubsection() AspectSubsectionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1230,6 +1897,9 @@ type InstanceSectionLike interface {
 
 	// Attribute Methods
 	GetInstanceDefinitions() abs.Sequential[InstanceDefinitionLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1245,6 +1915,42 @@ type InterfaceDefinitionsLike interface {
 	GetClassSection() ClassSectionLike
 	GetInstanceSection() InstanceSectionLike
 	GetOptionalAspectSection() AspectSectionLike
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+InvocationLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete invocation-like class.
+*/
+type InvocationLike interface {
+	// Public Methods
+	GetClass() InvocationClassLike
+
+	// Attribute Methods
+	GetOptionalExpression() ExpressionLike
+	GetAdditionalExpressions() abs.Sequential[AdditionalExpressionLike]
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+LocationLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete location-like class.  The line and
+column numbers are one based and the offset is a zero based byte offset.
+*/
+type LocationLike interface {
+	// Public Methods
+	GetClass() LocationClassLike
+
+	// Attribute Methods
+	GetLine() uint
+	GetColumn() uint
+	GetOffset() uint
 }
 
 /*
@@ -1258,6 +1964,9 @@ type MapLike interface {
 
 	// Attribute Methods
 	GetName() string
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1270,9 +1979,13 @@ type MethodLike interface {
 	GetClass() MethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetParameters() abs.Sequential[ParameterLike]
 	GetOptionalResult() ResultLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1288,6 +2001,9 @@ type ModelLike interface {
 	GetModuleDefinition() ModuleDefinitionLike
 	GetPrimitiveDefinitions() PrimitiveDefinitionsLike
 	GetInterfaceDefinitions() InterfaceDefinitionsLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1300,8 +2016,11 @@ type ModuleLike interface {
 	GetClass() ModuleClassLike
 
 	// Attribute Methods
-	GetName() string
+	GetOptionalAlias() AliasLike
 	GetPath() string
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1317,6 +2036,9 @@ type ModuleDefinitionLike interface {
 	GetNotice() NoticeLike
 	GetHeader() HeaderLike
 	GetOptionalImports() ImportsLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1330,6 +2052,9 @@ type NoneLike interface {
 
 	// Attribute Methods
 	GetNewline() string
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1343,6 +2068,75 @@ type NoticeLike interface {
 
 	// Attribute Methods
 	GetComment() string
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+OperandLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete operand-like class.
+*/
+type OperandLike interface {
+	// Public Methods
+	GetClass() OperandClassLike
+
+	// Attribute Methods
+	GetAny() any
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+OperationLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete operation-like class.
+*/
+type OperationLike interface {
+	// Public Methods
+	GetClass() OperationClassLike
+
+	// Attribute Methods
+	GetOperator() OperatorLike
+	GetOperand() OperandLike
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+OperatorLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete operator-like class.
+*/
+type OperatorLike interface {
+	// Public Methods
+	GetClass() OperatorClassLike
+
+	// Attribute Methods
+	GetAny() any
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+OutputLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete output-like class.
+*/
+type OutputLike interface {
+	// Public Methods
+	GetClass() OutputClassLike
+
+	// Attribute Methods
+	GetName() string
+	GetAbstraction() AbstractionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1356,7 +2150,11 @@ type ParameterLike interface {
 
 	// Attribute Methods
 	GetName() string
+	IsVariadic() bool
 	GetAbstraction() AbstractionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1369,7 +2167,40 @@ type ParameterizedLike interface {
 	GetClass() ParameterizedClassLike
 
 	// Attribute Methods
-	GetParameters() abs.Sequential[ParameterLike]
+	GetOutput() OutputLike
+	GetAdditionalOutputs() abs.Sequential[AdditionalOutputLike]
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+PointerLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete pointer-like class.
+*/
+type PointerLike interface {
+	// Public Methods
+	GetClass() PointerClassLike
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+PrecedenceLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete precedence-like class.
+*/
+type PrecedenceLike interface {
+	// Public Methods
+	GetClass() PrecedenceClassLike
+
+	// Attribute Methods
+	GetExpression() ExpressionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1383,6 +2214,9 @@ type PrefixLike interface {
 
 	// Attribute Methods
 	GetAny() any
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1397,6 +2231,10 @@ type PrimitiveDefinitionsLike interface {
 	// Attribute Methods
 	GetOptionalTypeSection() TypeSectionLike
 	GetOptionalFunctionalSection() FunctionalSectionLike
+	GetOptionalConstantSection() ConstantSectionLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1410,6 +2248,9 @@ type PublicMethodLike interface {
 
 	// Attribute Methods
 	GetMethod() MethodLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1423,6 +2264,43 @@ type PublicSubsectionLike interface {
 
 	// Attribute Methods
 	GetPublicMethods() abs.Sequential[PublicMethodLike]
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+QualifierLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete qualifier-like class.
+*/
+type QualifierLike interface {
+	// Public Methods
+	GetClass() QualifierClassLike
+
+	// Attribute Methods
+	GetAny() any
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+ReferenceLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete reference-like class.
+*/
+type ReferenceLike interface {
+	// Public Methods
+	GetClass() ReferenceClassLike
+
+	// Attribute Methods
+	GetName() string
+	GetOptionalSuffix() SuffixLike
+	GetOptionalInvocation() InvocationLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1436,6 +2314,9 @@ type ResultLike interface {
 
 	// Attribute Methods
 	GetAny() any
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1448,8 +2329,59 @@ type SetterMethodLike interface {
 	GetClass() SetterMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetParameter() ParameterLike
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+SizeLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete size-like class.
+*/
+type SizeLike interface {
+	// Public Methods
+	GetClass() SizeClassLike
+
+	// Attribute Methods
+	GetAny() any
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+SpanLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete span-like class.  The end
+location is just past the last character in the span.
+*/
+type SpanLike interface {
+	// Public Methods
+	GetClass() SpanClassLike
+
+	// Attribute Methods
+	GetStart() LocationLike
+	GetEnd() LocationLike
+}
+
+/*
+StructureLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete structure-like class.
+*/
+type StructureLike interface {
+	// Public Methods
+	GetClass() StructureClassLike
+
+	// Attribute Methods
+	GetFields() abs.Sequential[FieldLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1463,6 +2395,43 @@ type SuffixLike interface {
 
 	// Attribute Methods
 	GetName() string
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+TermLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete term-like class.
+*/
+type TermLike interface {
+	// Public Methods
+	GetClass() TermClassLike
+
+	// Attribute Methods
+	IsApproximate() bool
+	GetAbstraction() AbstractionLike
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+TupleLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete tuple-like class.
+*/
+type TupleLike interface {
+	// Public Methods
+	GetClass() TupleClassLike
+
+	// Attribute Methods
+	GetArgument() ArgumentLike
+	GetAdditionalArguments() abs.Sequential[AdditionalArgumentLike]
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1476,8 +2445,11 @@ type TypeDefinitionLike interface {
 
 	// Attribute Methods
 	GetDeclaration() DeclarationLike
-	GetAbstraction() AbstractionLike
+	GetUnderlying() UnderlyingLike
 	GetOptionalEnumeration() EnumerationLike
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1491,6 +2463,42 @@ type TypeSectionLike interface {
 
 	// Attribute Methods
 	GetTypeDefinitions() abs.Sequential[TypeDefinitionLike]
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+UnaryLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete unary-like class.
+*/
+type UnaryLike interface {
+	// Public Methods
+	GetClass() UnaryClassLike
+
+	// Attribute Methods
+	GetOperator() string
+	GetOperand() OperandLike
+
+	// Aspect Methods
+	Spanned
+}
+
+/*
+UnderlyingLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete underlying-like class.
+*/
+type UnderlyingLike interface {
+	// Public Methods
+	GetClass() UnderlyingClassLike
+
+	// Attribute Methods
+	GetAny() any
+
+	// Aspect Methods
+	Spanned
 }
 
 /*
@@ -1505,4 +2513,21 @@ type ValueLike interface {
 	// Attribute Methods
 	GetName() string
 	GetAbstraction() AbstractionLike
+	GetInitializer() InitializerLike
+
+	// Aspect Methods
+	Spanned
+}
+
+// Aspect Definitions
+
+/*
+Spanned defines the set of method signatures that must be supported by all
+AST nodes that know where in the source they were parsed from.
+*/
+type Spanned interface {
+	GetSpan() SpanLike
+	SetSpan(
+		span SpanLike,
+	)
 }
@@ -13,6 +13,7 @@
 package ast
 
 import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	uti "github.com/craterdog/go-missing-utilities/v2"
 )
 
@@ -27,17 +28,20 @@ func Abstraction() AbstractionClassLike {
 // Constructor Methods
 
 func (c *abstractionClass_) Make(
-	optionalPrefix PrefixLike,
+	prefixes abs.Sequential[PrefixLike],
 	name string,
 	optionalSuffix SuffixLike,
 	optionalArguments ArgumentsLike,
 ) AbstractionLike {
+	if uti.IsUndefined(prefixes) {
+		panic("The \"prefixes\" attribute is required by this class.")
+	}
 	if uti.IsUndefined(name) {
 		panic("The \"name\" attribute is required by this class.")
 	}
 	var instance = &abstraction_{
 		// Initialize the instance attributes.
-		optionalPrefix_:    optionalPrefix,
+		prefixes_:          prefixes,
 		name_:              name,
 		optionalSuffix_:    optionalSuffix,
 		optionalArguments_: optionalArguments,
@@ -46,12 +50,35 @@ func (c *abstractionClass_) Make(
 
 }
 
+func (c *abstractionClass_) MakeWithFunction(
+	prefixes abs.Sequential[PrefixLike],
+	function FunctionLike,
+) AbstractionLike {
+	if uti.IsUndefined(prefixes) {
+		panic("The \"prefixes\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(function) {
+		panic("The \"function\" attribute is required by this class.")
+	}
+	var instance = &abstraction_{
+		// Initialize the instance attributes.
+		prefixes_:         prefixes,
+		optionalFunction_: function,
+	}
+	return instance
+
+}
+
 // INSTANCE INTERFACE
 
 // Attribute Methods
 
-func (v *abstraction_) GetOptionalPrefix() PrefixLike {
-	return v.optionalPrefix_
+func (v *abstraction_) GetPrefixes() abs.Sequential[PrefixLike] {
+	return v.prefixes_
+}
+
+func (v *abstraction_) GetOptionalFunction() FunctionLike {
+	return v.optionalFunction_
 }
 
 func (v *abstraction_) GetName() string {
@@ -66,6 +93,18 @@ func (v *abstraction_) GetOptionalArguments() ArgumentsLike {
 	return v.optionalArguments_
 }
 
+// Spanned Methods
+
+func (v *abstraction_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *abstraction_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *abstraction_) GetClass() AbstractionClassLike {
@@ -84,10 +123,14 @@ func (v *abstraction_) getClass() *abstractionClass_ {
 
 type abstraction_ struct {
 	// Declare the instance attributes.
-	optionalPrefix_    PrefixLike
+	prefixes_          abs.Sequential[PrefixLike]
+	optionalFunction_  FunctionLike
 	name_              string
 	optionalSuffix_    SuffixLike
 	optionalArguments_ ArgumentsLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
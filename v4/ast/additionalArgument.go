@@ -48,6 +48,18 @@ func (v *additionalArgument_) GetArgument() ArgumentLike {
 	return v.argument_
 }
 
+// Spanned Methods
+
+func (v *additionalArgument_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *additionalArgument_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *additionalArgument_) GetClass() AdditionalArgumentClassLike {
@@ -67,6 +79,9 @@ func (v *additionalArgument_) getClass() *additionalArgumentClass_ {
 type additionalArgument_ struct {
 	// Declare the instance attributes.
 	argument_ ArgumentLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -48,6 +48,18 @@ func (v *additionalConstraint_) GetConstraint() ConstraintLike {
 	return v.constraint_
 }
 
+// Spanned Methods
+
+func (v *additionalConstraint_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *additionalConstraint_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *additionalConstraint_) GetClass() AdditionalConstraintClassLike {
@@ -67,6 +79,9 @@ func (v *additionalConstraint_) getClass() *additionalConstraintClass_ {
 type additionalConstraint_ struct {
 	// Declare the instance attributes.
 	constraint_ ConstraintLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func AdditionalExpression() AdditionalExpressionClassLike {
+	return additionalExpressionReference()
+}
+
+// Constructor Methods
+
+func (c *additionalExpressionClass_) Make(
+	expression ExpressionLike,
+) AdditionalExpressionLike {
+	if uti.IsUndefined(expression) {
+		panic("The \"expression\" attribute is required by this class.")
+	}
+	var instance = &additionalExpression_{
+		// Initialize the instance attributes.
+		expression_: expression,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *additionalExpression_) GetExpression() ExpressionLike {
+	return v.expression_
+}
+
+// Spanned Methods
+
+func (v *additionalExpression_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *additionalExpression_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *additionalExpression_) GetClass() AdditionalExpressionClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *additionalExpression_) getClass() *additionalExpressionClass_ {
+	return additionalExpressionReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type additionalExpression_ struct {
+	// Declare the instance attributes.
+	expression_ ExpressionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type additionalExpressionClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func additionalExpressionReference() *additionalExpressionClass_ {
+	return additionalExpressionReference_
+}
+
+var additionalExpressionReference_ = &additionalExpressionClass_{
+	// Initialize the class constants.
+}
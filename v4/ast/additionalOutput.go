@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func AdditionalOutput() AdditionalOutputClassLike {
+	return additionalOutputReference()
+}
+
+// Constructor Methods
+
+func (c *additionalOutputClass_) Make(
+	output OutputLike,
+) AdditionalOutputLike {
+	if uti.IsUndefined(output) {
+		panic("The \"output\" attribute is required by this class.")
+	}
+	var instance = &additionalOutput_{
+		// Initialize the instance attributes.
+		output_: output,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *additionalOutput_) GetOutput() OutputLike {
+	return v.output_
+}
+
+// Spanned Methods
+
+func (v *additionalOutput_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *additionalOutput_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *additionalOutput_) GetClass() AdditionalOutputClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *additionalOutput_) getClass() *additionalOutputClass_ {
+	return additionalOutputReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type additionalOutput_ struct {
+	// Declare the instance attributes.
+	output_ OutputLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type additionalOutputClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func additionalOutputReference() *additionalOutputClass_ {
+	return additionalOutputReference_
+}
+
+var additionalOutputReference_ = &additionalOutputClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func AdditionalTerm() AdditionalTermClassLike {
+	return additionalTermReference()
+}
+
+// Constructor Methods
+
+func (c *additionalTermClass_) Make(
+	term TermLike,
+) AdditionalTermLike {
+	if uti.IsUndefined(term) {
+		panic("The \"term\" attribute is required by this class.")
+	}
+	var instance = &additionalTerm_{
+		// Initialize the instance attributes.
+		term_: term,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *additionalTerm_) GetTerm() TermLike {
+	return v.term_
+}
+
+// Spanned Methods
+
+func (v *additionalTerm_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *additionalTerm_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *additionalTerm_) GetClass() AdditionalTermClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *additionalTerm_) getClass() *additionalTermClass_ {
+	return additionalTermReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type additionalTerm_ struct {
+	// Declare the instance attributes.
+	term_ TermLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type additionalTermClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func additionalTermReference() *additionalTermClass_ {
+	return additionalTermReference_
+}
+
+var additionalTermReference_ = &additionalTermClass_{
+	// Initialize the class constants.
+}
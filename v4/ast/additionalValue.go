@@ -28,13 +28,17 @@ func AdditionalValue() AdditionalValueClassLike {
 
 func (c *additionalValueClass_) Make(
 	name string,
+	optionalAbstraction AbstractionLike,
+	optionalInitializer InitializerLike,
 ) AdditionalValueLike {
 	if uti.IsUndefined(name) {
 		panic("The \"name\" attribute is required by this class.")
 	}
 	var instance = &additionalValue_{
 		// Initialize the instance attributes.
-		name_: name,
+		name_:                name,
+		optionalAbstraction_: optionalAbstraction,
+		optionalInitializer_: optionalInitializer,
 	}
 	return instance
 
@@ -48,6 +52,26 @@ func (v *additionalValue_) GetName() string {
 	return v.name_
 }
 
+func (v *additionalValue_) GetOptionalAbstraction() AbstractionLike {
+	return v.optionalAbstraction_
+}
+
+func (v *additionalValue_) GetOptionalInitializer() InitializerLike {
+	return v.optionalInitializer_
+}
+
+// Spanned Methods
+
+func (v *additionalValue_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *additionalValue_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *additionalValue_) GetClass() AdditionalValueClassLike {
@@ -66,7 +90,12 @@ func (v *additionalValue_) getClass() *additionalValueClass_ {
 
 type additionalValue_ struct {
 	// Declare the instance attributes.
-	name_ string
+	name_                string
+	optionalAbstraction_ AbstractionLike
+	optionalInitializer_ InitializerLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Alias() AliasClassLike {
+	return aliasReference()
+}
+
+// Constructor Methods
+
+func (c *aliasClass_) Make(
+	any_ any,
+) AliasLike {
+	if uti.IsUndefined(any_) {
+		panic("The \"any_\" attribute is required by this class.")
+	}
+	var instance = &alias_{
+		// Initialize the instance attributes.
+		any__: any_,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *alias_) GetAny() any {
+	return v.any__
+}
+
+// Spanned Methods
+
+func (v *alias_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *alias_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *alias_) GetClass() AliasClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *alias_) getClass() *aliasClass_ {
+	return aliasReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type alias_ struct {
+	// Declare the instance attributes.
+	any__ any
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type aliasClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func aliasReference() *aliasClass_ {
+	return aliasReference_
+}
+
+var aliasReference_ = &aliasClass_{
+	// Initialize the class constants.
+}
@@ -48,6 +48,18 @@ func (v *argument_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
+// Spanned Methods
+
+func (v *argument_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *argument_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *argument_) GetClass() ArgumentClassLike {
@@ -67,6 +79,9 @@ func (v *argument_) getClass() *argumentClass_ {
 type argument_ struct {
 	// Declare the instance attributes.
 	abstraction_ AbstractionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
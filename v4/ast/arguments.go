@@ -58,6 +58,18 @@ func (v *arguments_) GetAdditionalArguments() abs.Sequential[AdditionalArgumentL
 	return v.additionalArguments_
 }
 
+// Spanned Methods
+
+func (v *arguments_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *arguments_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *arguments_) GetClass() ArgumentsClassLike {
@@ -78,6 +90,9 @@ type arguments_ struct {
 	// Declare the instance attributes.
 	argument_            ArgumentLike
 	additionalArguments_ abs.Sequential[AdditionalArgumentLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
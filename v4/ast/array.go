@@ -22,9 +22,12 @@ func Array() ArrayClassLike {
 
 // Constructor Methods
 
-func (c *arrayClass_) Make() ArrayLike {
+func (c *arrayClass_) Make(
+	optionalSize SizeLike,
+) ArrayLike {
 	var instance = &array_{
 		// Initialize the instance attributes.
+		optionalSize_: optionalSize,
 	}
 	return instance
 
@@ -32,6 +35,24 @@ func (c *arrayClass_) Make() ArrayLike {
 
 // INSTANCE INTERFACE
 
+// Attribute Methods
+
+func (v *array_) GetOptionalSize() SizeLike {
+	return v.optionalSize_
+}
+
+// Spanned Methods
+
+func (v *array_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *array_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *array_) GetClass() ArrayClassLike {
@@ -50,6 +71,10 @@ func (v *array_) getClass() *arrayClass_ {
 
 type array_ struct {
 	// Declare the instance attributes.
+	optionalSize_ SizeLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
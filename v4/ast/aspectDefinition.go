@@ -29,18 +29,23 @@ func AspectDefinition() AspectDefinitionClassLike {
 
 func (c *aspectDefinitionClass_) Make(
 	declaration DeclarationLike,
+	aspectInterfaces abs.Sequential[AspectInterfaceLike],
 	aspectMethods abs.Sequential[AspectMethodLike],
 ) AspectDefinitionLike {
 	if uti.IsUndefined(declaration) {
 		panic("The \"declaration\" attribute is required by this class.")
 	}
+	if uti.IsUndefined(aspectInterfaces) {
+		panic("The \"aspectInterfaces\" attribute is required by this class.")
+	}
 	if uti.IsUndefined(aspectMethods) {
 		panic("The \"aspectMethods\" attribute is required by this class.")
 	}
 	var instance = &aspectDefinition_{
 		// Initialize the instance attributes.
-		declaration_:   declaration,
-		aspectMethods_: aspectMethods,
+		declaration_:      declaration,
+		aspectInterfaces_: aspectInterfaces,
+		aspectMethods_:    aspectMethods,
 	}
 	return instance
 
@@ -54,10 +59,26 @@ func (v *aspectDefinition_) GetDeclaration() DeclarationLike {
 	return v.declaration_
 }
 
+func (v *aspectDefinition_) GetAspectInterfaces() abs.Sequential[AspectInterfaceLike] {
+	return v.aspectInterfaces_
+}
+
 func (v *aspectDefinition_) GetAspectMethods() abs.Sequential[AspectMethodLike] {
 	return v.aspectMethods_
 }
 
+// Spanned Methods
+
+func (v *aspectDefinition_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *aspectDefinition_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *aspectDefinition_) GetClass() AspectDefinitionClassLike {
@@ -76,8 +97,12 @@ func (v *aspectDefinition_) getClass() *aspectDefinitionClass_ {
 
 type aspectDefinition_ struct {
 	// Declare the instance attributes.
-	declaration_   DeclarationLike
-	aspectMethods_ abs.Sequential[AspectMethodLike]
+	declaration_      DeclarationLike
+	aspectInterfaces_ abs.Sequential[AspectInterfaceLike]
+	aspectMethods_    abs.Sequential[AspectMethodLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -48,6 +48,18 @@ func (v *aspectInterface_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
+// Spanned Methods
+
+func (v *aspectInterface_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *aspectInterface_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *aspectInterface_) GetClass() AspectInterfaceClassLike {
@@ -67,6 +79,9 @@ func (v *aspectInterface_) getClass() *aspectInterfaceClass_ {
 type aspectInterface_ struct {
 	// Declare the instance attributes.
 	abstraction_ AbstractionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
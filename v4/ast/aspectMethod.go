@@ -48,6 +48,18 @@ func (v *aspectMethod_) GetMethod() MethodLike {
 	return v.method_
 }
 
+// Spanned Methods
+
+func (v *aspectMethod_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *aspectMethod_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *aspectMethod_) GetClass() AspectMethodClassLike {
@@ -67,6 +79,9 @@ func (v *aspectMethod_) getClass() *aspectMethodClass_ {
 type aspectMethod_ struct {
 	// Declare the instance attributes.
 	method_ MethodLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -49,6 +49,18 @@ func (v *aspectSection_) GetAspectDefinitions() abs.Sequential[AspectDefinitionL
 	return v.aspectDefinitions_
 }
 
+// Spanned Methods
+
+func (v *aspectSection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *aspectSection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *aspectSection_) GetClass() AspectSectionClassLike {
@@ -68,6 +80,9 @@ func (v *aspectSection_) getClass() *aspectSectionClass_ {
 type aspectSection_ struct {
 	// Declare the instance attributes.
 	aspectDefinitions_ abs.Sequential[AspectDefinitionLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
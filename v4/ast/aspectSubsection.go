@@ -49,6 +49,18 @@ func (v *aspectSubsection_) GetAspectInterfaces() abs.Sequential[AspectInterface
 	return v.aspectInterfaces_
 }
 
+// Spanned Methods
+
+func (v *aspectSubsection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *aspectSubsection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *aspectSubsection_) GetClass() AspectSubsectionClassLike {
@@ -68,6 +80,9 @@ func (v *aspectSubsection_) getClass() *aspectSubsectionClass_ {
 type aspectSubsection_ struct {
 	// Declare the instance attributes.
 	aspectInterfaces_ abs.Sequential[AspectInterfaceLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
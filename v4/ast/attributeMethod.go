@@ -48,6 +48,18 @@ func (v *attributeMethod_) GetAny() any {
 	return v.any_
 }
 
+// Spanned Methods
+
+func (v *attributeMethod_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *attributeMethod_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *attributeMethod_) GetClass() AttributeMethodClassLike {
@@ -67,6 +79,9 @@ func (v *attributeMethod_) getClass() *attributeMethodClass_ {
 type attributeMethod_ struct {
 	// Declare the instance attributes.
 	any_ any
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
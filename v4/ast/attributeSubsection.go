@@ -49,6 +49,18 @@ func (v *attributeSubsection_) GetAttributeMethods() abs.Sequential[AttributeMet
 	return v.attributeMethods_
 }
 
+// Spanned Methods
+
+func (v *attributeSubsection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *attributeSubsection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *attributeSubsection_) GetClass() AttributeSubsectionClassLike {
@@ -68,6 +80,9 @@ func (v *attributeSubsection_) getClass() *attributeSubsectionClass_ {
 type attributeSubsection_ struct {
 	// Declare the instance attributes.
 	attributeMethods_ abs.Sequential[AttributeMethodLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -22,9 +22,12 @@ func Channel() ChannelClassLike {
 
 // Constructor Methods
 
-func (c *channelClass_) Make() ChannelLike {
+func (c *channelClass_) Make(
+	direction Direction,
+) ChannelLike {
 	var instance = &channel_{
 		// Initialize the instance attributes.
+		direction_: direction,
 	}
 	return instance
 
@@ -32,6 +35,24 @@ func (c *channelClass_) Make() ChannelLike {
 
 // INSTANCE INTERFACE
 
+// Attribute Methods
+
+func (v *channel_) GetDirection() Direction {
+	return v.direction_
+}
+
+// Spanned Methods
+
+func (v *channel_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *channel_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *channel_) GetClass() ChannelClassLike {
@@ -50,6 +71,10 @@ func (v *channel_) getClass() *channelClass_ {
 
 type channel_ struct {
 	// Declare the instance attributes.
+	direction_ Direction
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
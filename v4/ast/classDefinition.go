@@ -57,6 +57,18 @@ func (v *classDefinition_) GetClassMethods() ClassMethodsLike {
 	return v.classMethods_
 }
 
+// Spanned Methods
+
+func (v *classDefinition_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *classDefinition_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *classDefinition_) GetClass() ClassDefinitionClassLike {
@@ -77,6 +89,9 @@ type classDefinition_ struct {
 	// Declare the instance attributes.
 	declaration_  DeclarationLike
 	classMethods_ ClassMethodsLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
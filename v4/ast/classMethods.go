@@ -60,6 +60,18 @@ func (v *classMethods_) GetOptionalFunctionSubsection() FunctionSubsectionLike {
 	return v.optionalFunctionSubsection_
 }
 
+// Spanned Methods
+
+func (v *classMethods_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *classMethods_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *classMethods_) GetClass() ClassMethodsClassLike {
@@ -81,6 +93,9 @@ type classMethods_ struct {
 	constructorSubsection_      ConstructorSubsectionLike
 	optionalConstantSubsection_ ConstantSubsectionLike
 	optionalFunctionSubsection_ FunctionSubsectionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -49,6 +49,18 @@ func (v *classSection_) GetClassDefinitions() abs.Sequential[ClassDefinitionLike
 	return v.classDefinitions_
 }
 
+// Spanned Methods
+
+func (v *classSection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *classSection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *classSection_) GetClass() ClassSectionClassLike {
@@ -68,6 +80,9 @@ func (v *classSection_) getClass() *classSectionClass_ {
 type classSection_ struct {
 	// Declare the instance attributes.
 	classDefinitions_ abs.Sequential[ClassDefinitionLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
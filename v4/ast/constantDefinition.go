@@ -0,0 +1,138 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ConstantDefinition() ConstantDefinitionClassLike {
+	return constantDefinitionReference()
+}
+
+// Constructor Methods
+
+func (c *constantDefinitionClass_) Make(
+	comment string,
+	qualifier QualifierLike,
+	name string,
+	optionalAbstraction AbstractionLike,
+	initializer InitializerLike,
+) ConstantDefinitionLike {
+	if uti.IsUndefined(comment) {
+		panic("The \"comment\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(qualifier) {
+		panic("The \"qualifier\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(name) {
+		panic("The \"name\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(initializer) {
+		panic("The \"initializer\" attribute is required by this class.")
+	}
+	var instance = &constantDefinition_{
+		// Initialize the instance attributes.
+		comment_:             comment,
+		qualifier_:           qualifier,
+		name_:                name,
+		optionalAbstraction_: optionalAbstraction,
+		initializer_:         initializer,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *constantDefinition_) GetComment() string {
+	return v.comment_
+}
+
+func (v *constantDefinition_) GetQualifier() QualifierLike {
+	return v.qualifier_
+}
+
+func (v *constantDefinition_) GetName() string {
+	return v.name_
+}
+
+func (v *constantDefinition_) GetOptionalAbstraction() AbstractionLike {
+	return v.optionalAbstraction_
+}
+
+func (v *constantDefinition_) GetInitializer() InitializerLike {
+	return v.initializer_
+}
+
+// Spanned Methods
+
+func (v *constantDefinition_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *constantDefinition_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *constantDefinition_) GetClass() ConstantDefinitionClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *constantDefinition_) getClass() *constantDefinitionClass_ {
+	return constantDefinitionReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type constantDefinition_ struct {
+	// Declare the instance attributes.
+	comment_             string
+	qualifier_           QualifierLike
+	name_                string
+	optionalAbstraction_ AbstractionLike
+	initializer_         InitializerLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type constantDefinitionClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func constantDefinitionReference() *constantDefinitionClass_ {
+	return constantDefinitionReference_
+}
+
+var constantDefinitionReference_ = &constantDefinitionClass_{
+	// Initialize the class constants.
+}
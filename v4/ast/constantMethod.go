@@ -27,6 +27,7 @@ func ConstantMethod() ConstantMethodClassLike {
 // Constructor Methods
 
 func (c *constantMethodClass_) Make(
+	optionalNote string,
 	name string,
 	abstraction AbstractionLike,
 ) ConstantMethodLike {
@@ -38,8 +39,9 @@ func (c *constantMethodClass_) Make(
 	}
 	var instance = &constantMethod_{
 		// Initialize the instance attributes.
-		name_:        name,
-		abstraction_: abstraction,
+		optionalNote_: optionalNote,
+		name_:         name,
+		abstraction_:  abstraction,
 	}
 	return instance
 
@@ -49,6 +51,10 @@ func (c *constantMethodClass_) Make(
 
 // Attribute Methods
 
+func (v *constantMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *constantMethod_) GetName() string {
 	return v.name_
 }
@@ -57,6 +63,18 @@ func (v *constantMethod_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
+// Spanned Methods
+
+func (v *constantMethod_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *constantMethod_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *constantMethod_) GetClass() ConstantMethodClassLike {
@@ -75,8 +93,12 @@ func (v *constantMethod_) getClass() *constantMethodClass_ {
 
 type constantMethod_ struct {
 	// Declare the instance attributes.
-	name_        string
-	abstraction_ AbstractionLike
+	optionalNote_ string
+	name_         string
+	abstraction_  AbstractionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
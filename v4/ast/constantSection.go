@@ -0,0 +1,102 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ConstantSection() ConstantSectionClassLike {
+	return constantSectionReference()
+}
+
+// Constructor Methods
+
+func (c *constantSectionClass_) Make(
+	constantDefinitions abs.Sequential[ConstantDefinitionLike],
+) ConstantSectionLike {
+	if uti.IsUndefined(constantDefinitions) {
+		panic("The \"constantDefinitions\" attribute is required by this class.")
+	}
+	var instance = &constantSection_{
+		// Initialize the instance attributes.
+		constantDefinitions_: constantDefinitions,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *constantSection_) GetConstantDefinitions() abs.Sequential[ConstantDefinitionLike] {
+	return v.constantDefinitions_
+}
+
+// Spanned Methods
+
+func (v *constantSection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *constantSection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *constantSection_) GetClass() ConstantSectionClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *constantSection_) getClass() *constantSectionClass_ {
+	return constantSectionReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type constantSection_ struct {
+	// Declare the instance attributes.
+	constantDefinitions_ abs.Sequential[ConstantDefinitionLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type constantSectionClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func constantSectionReference() *constantSectionClass_ {
+	return constantSectionReference_
+}
+
+var constantSectionReference_ = &constantSectionClass_{
+	// Initialize the class constants.
+}
@@ -49,6 +49,18 @@ func (v *constantSubsection_) GetConstantMethods() abs.Sequential[ConstantMethod
 	return v.constantMethods_
 }
 
+// Spanned Methods
+
+func (v *constantSubsection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *constantSubsection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *constantSubsection_) GetClass() ConstantSubsectionClassLike {
@@ -68,6 +80,9 @@ func (v *constantSubsection_) getClass() *constantSubsectionClass_ {
 type constantSubsection_ struct {
 	// Declare the instance attributes.
 	constantMethods_ abs.Sequential[ConstantMethodLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
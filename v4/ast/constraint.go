@@ -13,6 +13,7 @@
 package ast
 
 import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	uti "github.com/craterdog/go-missing-utilities/v2"
 )
 
@@ -28,18 +29,23 @@ func Constraint() ConstraintClassLike {
 
 func (c *constraintClass_) Make(
 	name string,
-	abstraction AbstractionLike,
+	term TermLike,
+	additionalTerms abs.Sequential[AdditionalTermLike],
 ) ConstraintLike {
 	if uti.IsUndefined(name) {
 		panic("The \"name\" attribute is required by this class.")
 	}
-	if uti.IsUndefined(abstraction) {
-		panic("The \"abstraction\" attribute is required by this class.")
+	if uti.IsUndefined(term) {
+		panic("The \"term\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(additionalTerms) {
+		panic("The \"additionalTerms\" attribute is required by this class.")
 	}
 	var instance = &constraint_{
 		// Initialize the instance attributes.
-		name_:        name,
-		abstraction_: abstraction,
+		name_:            name,
+		term_:            term,
+		additionalTerms_: additionalTerms,
 	}
 	return instance
 
@@ -53,8 +59,24 @@ func (v *constraint_) GetName() string {
 	return v.name_
 }
 
-func (v *constraint_) GetAbstraction() AbstractionLike {
-	return v.abstraction_
+func (v *constraint_) GetTerm() TermLike {
+	return v.term_
+}
+
+func (v *constraint_) GetAdditionalTerms() abs.Sequential[AdditionalTermLike] {
+	return v.additionalTerms_
+}
+
+// Spanned Methods
+
+func (v *constraint_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *constraint_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
 }
 
 // Public Methods
@@ -75,8 +97,12 @@ func (v *constraint_) getClass() *constraintClass_ {
 
 type constraint_ struct {
 	// Declare the instance attributes.
-	name_        string
-	abstraction_ AbstractionLike
+	name_            string
+	term_            TermLike
+	additionalTerms_ abs.Sequential[AdditionalTermLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
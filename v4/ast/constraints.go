@@ -58,6 +58,18 @@ func (v *constraints_) GetAdditionalConstraints() abs.Sequential[AdditionalConst
 	return v.additionalConstraints_
 }
 
+// Spanned Methods
+
+func (v *constraints_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *constraints_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *constraints_) GetClass() ConstraintsClassLike {
@@ -78,6 +90,9 @@ type constraints_ struct {
 	// Declare the instance attributes.
 	constraint_            ConstraintLike
 	additionalConstraints_ abs.Sequential[AdditionalConstraintLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
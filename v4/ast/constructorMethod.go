@@ -28,6 +28,7 @@ func ConstructorMethod() ConstructorMethodClassLike {
 // Constructor Methods
 
 func (c *constructorMethodClass_) Make(
+	optionalNote string,
 	name string,
 	parameters abs.Sequential[ParameterLike],
 	abstraction AbstractionLike,
@@ -43,9 +44,10 @@ func (c *constructorMethodClass_) Make(
 	}
 	var instance = &constructorMethod_{
 		// Initialize the instance attributes.
-		name_:        name,
-		parameters_:  parameters,
-		abstraction_: abstraction,
+		optionalNote_: optionalNote,
+		name_:         name,
+		parameters_:   parameters,
+		abstraction_:  abstraction,
 	}
 	return instance
 
@@ -55,6 +57,10 @@ func (c *constructorMethodClass_) Make(
 
 // Attribute Methods
 
+func (v *constructorMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *constructorMethod_) GetName() string {
 	return v.name_
 }
@@ -67,6 +73,18 @@ func (v *constructorMethod_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
+// Spanned Methods
+
+func (v *constructorMethod_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *constructorMethod_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *constructorMethod_) GetClass() ConstructorMethodClassLike {
@@ -85,9 +103,13 @@ func (v *constructorMethod_) getClass() *constructorMethodClass_ {
 
 type constructorMethod_ struct {
 	// Declare the instance attributes.
-	name_        string
-	parameters_  abs.Sequential[ParameterLike]
-	abstraction_ AbstractionLike
+	optionalNote_ string
+	name_         string
+	parameters_   abs.Sequential[ParameterLike]
+	abstraction_  AbstractionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -49,6 +49,18 @@ func (v *constructorSubsection_) GetConstructorMethods() abs.Sequential[Construc
 	return v.constructorMethods_
 }
 
+// Spanned Methods
+
+func (v *constructorSubsection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *constructorSubsection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *constructorSubsection_) GetClass() ConstructorSubsectionClassLike {
@@ -68,6 +80,9 @@ func (v *constructorSubsection_) getClass() *constructorSubsectionClass_ {
 type constructorSubsection_ struct {
 	// Declare the instance attributes.
 	constructorMethods_ abs.Sequential[ConstructorMethodLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
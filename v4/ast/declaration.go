@@ -63,6 +63,18 @@ func (v *declaration_) GetOptionalConstraints() ConstraintsLike {
 	return v.optionalConstraints_
 }
 
+// Spanned Methods
+
+func (v *declaration_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *declaration_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *declaration_) GetClass() DeclarationClassLike {
@@ -84,6 +96,9 @@ type declaration_ struct {
 	comment_             string
 	name_                string
 	optionalConstraints_ ConstraintsLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -58,6 +58,18 @@ func (v *enumeration_) GetAdditionalValues() abs.Sequential[AdditionalValueLike]
 	return v.additionalValues_
 }
 
+// Spanned Methods
+
+func (v *enumeration_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *enumeration_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *enumeration_) GetClass() EnumerationClassLike {
@@ -78,6 +90,9 @@ type enumeration_ struct {
 	// Declare the instance attributes.
 	value_            ValueLike
 	additionalValues_ abs.Sequential[AdditionalValueLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -0,0 +1,112 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Expression() ExpressionClassLike {
+	return expressionReference()
+}
+
+// Constructor Methods
+
+func (c *expressionClass_) Make(
+	operand OperandLike,
+	operations abs.Sequential[OperationLike],
+) ExpressionLike {
+	if uti.IsUndefined(operand) {
+		panic("The \"operand\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(operations) {
+		panic("The \"operations\" attribute is required by this class.")
+	}
+	var instance = &expression_{
+		// Initialize the instance attributes.
+		operand_:    operand,
+		operations_: operations,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *expression_) GetOperand() OperandLike {
+	return v.operand_
+}
+
+func (v *expression_) GetOperations() abs.Sequential[OperationLike] {
+	return v.operations_
+}
+
+// Spanned Methods
+
+func (v *expression_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *expression_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *expression_) GetClass() ExpressionClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *expression_) getClass() *expressionClass_ {
+	return expressionReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type expression_ struct {
+	// Declare the instance attributes.
+	operand_    OperandLike
+	operations_ abs.Sequential[OperationLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type expressionClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func expressionReference() *expressionClass_ {
+	return expressionReference_
+}
+
+var expressionReference_ = &expressionClass_{
+	// Initialize the class constants.
+}
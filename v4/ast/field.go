@@ -0,0 +1,118 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Field() FieldClassLike {
+	return fieldReference()
+}
+
+// Constructor Methods
+
+func (c *fieldClass_) Make(
+	name string,
+	abstraction AbstractionLike,
+	optionalTag string,
+) FieldLike {
+	if uti.IsUndefined(name) {
+		panic("The \"name\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(abstraction) {
+		panic("The \"abstraction\" attribute is required by this class.")
+	}
+	var instance = &field_{
+		// Initialize the instance attributes.
+		name_:        name,
+		abstraction_: abstraction,
+		optionalTag_: optionalTag,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *field_) GetName() string {
+	return v.name_
+}
+
+func (v *field_) GetAbstraction() AbstractionLike {
+	return v.abstraction_
+}
+
+func (v *field_) GetOptionalTag() string {
+	return v.optionalTag_
+}
+
+// Spanned Methods
+
+func (v *field_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *field_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *field_) GetClass() FieldClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *field_) getClass() *fieldClass_ {
+	return fieldReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type field_ struct {
+	// Declare the instance attributes.
+	name_        string
+	abstraction_ AbstractionLike
+	optionalTag_ string
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type fieldClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func fieldReference() *fieldClass_ {
+	return fieldReference_
+}
+
+var fieldReference_ = &fieldClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,116 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Function() FunctionClassLike {
+	return functionReference()
+}
+
+// Constructor Methods
+
+func (c *functionClass_) Make(
+	optionalArgument ArgumentLike,
+	additionalArguments abs.Sequential[AdditionalArgumentLike],
+	optionalResult ResultLike,
+) FunctionLike {
+	if uti.IsUndefined(additionalArguments) {
+		panic("The \"additionalArguments\" attribute is required by this class.")
+	}
+	var instance = &function_{
+		// Initialize the instance attributes.
+		optionalArgument_:    optionalArgument,
+		additionalArguments_: additionalArguments,
+		optionalResult_:      optionalResult,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *function_) GetOptionalArgument() ArgumentLike {
+	return v.optionalArgument_
+}
+
+func (v *function_) GetAdditionalArguments() abs.Sequential[AdditionalArgumentLike] {
+	return v.additionalArguments_
+}
+
+func (v *function_) GetOptionalResult() ResultLike {
+	return v.optionalResult_
+}
+
+// Spanned Methods
+
+func (v *function_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *function_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *function_) GetClass() FunctionClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *function_) getClass() *functionClass_ {
+	return functionReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type function_ struct {
+	// Declare the instance attributes.
+	optionalArgument_    ArgumentLike
+	additionalArguments_ abs.Sequential[AdditionalArgumentLike]
+	optionalResult_      ResultLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type functionClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func functionReference() *functionClass_ {
+	return functionReference_
+}
+
+var functionReference_ = &functionClass_{
+	// Initialize the class constants.
+}
@@ -28,6 +28,7 @@ func FunctionMethod() FunctionMethodClassLike {
 // Constructor Methods
 
 func (c *functionMethodClass_) Make(
+	optionalNote string,
 	name string,
 	parameters abs.Sequential[ParameterLike],
 	result ResultLike,
@@ -43,9 +44,10 @@ func (c *functionMethodClass_) Make(
 	}
 	var instance = &functionMethod_{
 		// Initialize the instance attributes.
-		name_:       name,
-		parameters_: parameters,
-		result_:     result,
+		optionalNote_: optionalNote,
+		name_:         name,
+		parameters_:   parameters,
+		result_:       result,
 	}
 	return instance
 
@@ -55,6 +57,10 @@ func (c *functionMethodClass_) Make(
 
 // Attribute Methods
 
+func (v *functionMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *functionMethod_) GetName() string {
 	return v.name_
 }
@@ -67,6 +73,18 @@ func (v *functionMethod_) GetResult() ResultLike {
 	return v.result_
 }
 
+// Spanned Methods
+
+func (v *functionMethod_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *functionMethod_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *functionMethod_) GetClass() FunctionMethodClassLike {
@@ -85,9 +103,13 @@ func (v *functionMethod_) getClass() *functionMethodClass_ {
 
 type functionMethod_ struct {
 	// Declare the instance attributes.
-	name_       string
-	parameters_ abs.Sequential[ParameterLike]
-	result_     ResultLike
+	optionalNote_ string
+	name_         string
+	parameters_   abs.Sequential[ParameterLike]
+	result_       ResultLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
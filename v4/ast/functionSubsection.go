@@ -49,6 +49,18 @@ func (v *functionSubsection_) GetFunctionMethods() abs.Sequential[FunctionMethod
 	return v.functionMethods_
 }
 
+// Spanned Methods
+
+func (v *functionSubsection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *functionSubsection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *functionSubsection_) GetClass() FunctionSubsectionClassLike {
@@ -68,6 +80,9 @@ func (v *functionSubsection_) getClass() *functionSubsectionClass_ {
 type functionSubsection_ struct {
 	// Declare the instance attributes.
 	functionMethods_ abs.Sequential[FunctionMethodLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -67,6 +67,18 @@ func (v *functionalDefinition_) GetResult() ResultLike {
 	return v.result_
 }
 
+// Spanned Methods
+
+func (v *functionalDefinition_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *functionalDefinition_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *functionalDefinition_) GetClass() FunctionalDefinitionClassLike {
@@ -88,6 +100,9 @@ type functionalDefinition_ struct {
 	declaration_ DeclarationLike
 	parameters_  abs.Sequential[ParameterLike]
 	result_      ResultLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
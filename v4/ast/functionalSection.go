@@ -49,6 +49,18 @@ func (v *functionalSection_) GetFunctionalDefinitions() abs.Sequential[Functiona
 	return v.functionalDefinitions_
 }
 
+// Spanned Methods
+
+func (v *functionalSection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *functionalSection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *functionalSection_) GetClass() FunctionalSectionClassLike {
@@ -68,6 +80,9 @@ func (v *functionalSection_) getClass() *functionalSectionClass_ {
 type functionalSection_ struct {
 	// Declare the instance attributes.
 	functionalDefinitions_ abs.Sequential[FunctionalDefinitionLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
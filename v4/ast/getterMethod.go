@@ -27,6 +27,7 @@ func GetterMethod() GetterMethodClassLike {
 // Constructor Methods
 
 func (c *getterMethodClass_) Make(
+	optionalNote string,
 	name string,
 	abstraction AbstractionLike,
 ) GetterMethodLike {
@@ -38,8 +39,9 @@ func (c *getterMethodClass_) Make(
 	}
 	var instance = &getterMethod_{
 		// Initialize the instance attributes.
-		name_:        name,
-		abstraction_: abstraction,
+		optionalNote_: optionalNote,
+		name_:         name,
+		abstraction_:  abstraction,
 	}
 	return instance
 
@@ -49,6 +51,10 @@ func (c *getterMethodClass_) Make(
 
 // Attribute Methods
 
+func (v *getterMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *getterMethod_) GetName() string {
 	return v.name_
 }
@@ -57,6 +63,18 @@ func (v *getterMethod_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
+// Spanned Methods
+
+func (v *getterMethod_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *getterMethod_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *getterMethod_) GetClass() GetterMethodClassLike {
@@ -75,8 +93,12 @@ func (v *getterMethod_) getClass() *getterMethodClass_ {
 
 type getterMethod_ struct {
 	// Declare the instance attributes.
-	name_        string
-	abstraction_ AbstractionLike
+	optionalNote_ string
+	name_         string
+	abstraction_  AbstractionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -57,6 +57,18 @@ func (v *header_) GetName() string {
 	return v.name_
 }
 
+// Spanned Methods
+
+func (v *header_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *header_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *header_) GetClass() HeaderClassLike {
@@ -77,6 +89,9 @@ type header_ struct {
 	// Declare the instance attributes.
 	comment_ string
 	name_    string
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -49,6 +49,18 @@ func (v *imports_) GetModules() abs.Sequential[ModuleLike] {
 	return v.modules_
 }
 
+// Spanned Methods
+
+func (v *imports_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *imports_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *imports_) GetClass() ImportsClassLike {
@@ -68,6 +80,9 @@ func (v *imports_) getClass() *importsClass_ {
 type imports_ struct {
 	// Declare the instance attributes.
 	modules_ abs.Sequential[ModuleLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
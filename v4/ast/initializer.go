@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Initializer() InitializerClassLike {
+	return initializerReference()
+}
+
+// Constructor Methods
+
+func (c *initializerClass_) Make(
+	expression ExpressionLike,
+) InitializerLike {
+	if uti.IsUndefined(expression) {
+		panic("The \"expression\" attribute is required by this class.")
+	}
+	var instance = &initializer_{
+		// Initialize the instance attributes.
+		expression_: expression,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *initializer_) GetExpression() ExpressionLike {
+	return v.expression_
+}
+
+// Spanned Methods
+
+func (v *initializer_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *initializer_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *initializer_) GetClass() InitializerClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *initializer_) getClass() *initializerClass_ {
+	return initializerReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type initializer_ struct {
+	// Declare the instance attributes.
+	expression_ ExpressionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type initializerClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func initializerReference() *initializerClass_ {
+	return initializerReference_
+}
+
+var initializerReference_ = &initializerClass_{
+	// Initialize the class constants.
+}
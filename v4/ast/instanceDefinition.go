@@ -57,6 +57,18 @@ func (v *instanceDefinition_) GetInstanceMethods() InstanceMethodsLike {
 	return v.instanceMethods_
 }
 
+// Spanned Methods
+
+func (v *instanceDefinition_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *instanceDefinition_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *instanceDefinition_) GetClass() InstanceDefinitionClassLike {
@@ -77,6 +89,9 @@ type instanceDefinition_ struct {
 	// Declare the instance attributes.
 	declaration_     DeclarationLike
 	instanceMethods_ InstanceMethodsLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
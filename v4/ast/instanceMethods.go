@@ -60,6 +60,18 @@ func (v *instanceMethods_) GetOptionalAspectSubsection() AspectSubsectionLike {
 	return v.optionalAspectSubsection_
 }
 
+// Spanned Methods
+
+func (v *instanceMethods_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *instanceMethods_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *instanceMethods_) GetClass() InstanceMethodsClassLike {
@@ -81,6 +93,9 @@ type instanceMethods_ struct {
 	publicSubsection_            PublicSubsectionLike
 	optionalAttributeSubsection_ AttributeSubsectionLike
 	optionalAspectSubsection_    AspectSubsectionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
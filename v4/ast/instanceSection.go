@@ -49,6 +49,18 @@ func (v *instanceSection_) GetInstanceDefinitions() abs.Sequential[InstanceDefin
 	return v.instanceDefinitions_
 }
 
+// Spanned Methods
+
+func (v *instanceSection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *instanceSection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *instanceSection_) GetClass() InstanceSectionClassLike {
@@ -68,6 +80,9 @@ func (v *instanceSection_) getClass() *instanceSectionClass_ {
 type instanceSection_ struct {
 	// Declare the instance attributes.
 	instanceDefinitions_ abs.Sequential[InstanceDefinitionLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -63,6 +63,18 @@ func (v *interfaceDefinitions_) GetOptionalAspectSection() AspectSectionLike {
 	return v.optionalAspectSection_
 }
 
+// Spanned Methods
+
+func (v *interfaceDefinitions_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *interfaceDefinitions_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *interfaceDefinitions_) GetClass() InterfaceDefinitionsClassLike {
@@ -84,6 +96,9 @@ type interfaceDefinitions_ struct {
 	classSection_          ClassSectionLike
 	instanceSection_       InstanceSectionLike
 	optionalAspectSection_ AspectSectionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
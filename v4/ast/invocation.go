@@ -0,0 +1,109 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Invocation() InvocationClassLike {
+	return invocationReference()
+}
+
+// Constructor Methods
+
+func (c *invocationClass_) Make(
+	optionalExpression ExpressionLike,
+	additionalExpressions abs.Sequential[AdditionalExpressionLike],
+) InvocationLike {
+	if uti.IsUndefined(additionalExpressions) {
+		panic("The \"additionalExpressions\" attribute is required by this class.")
+	}
+	var instance = &invocation_{
+		// Initialize the instance attributes.
+		optionalExpression_:    optionalExpression,
+		additionalExpressions_: additionalExpressions,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *invocation_) GetOptionalExpression() ExpressionLike {
+	return v.optionalExpression_
+}
+
+func (v *invocation_) GetAdditionalExpressions() abs.Sequential[AdditionalExpressionLike] {
+	return v.additionalExpressions_
+}
+
+// Spanned Methods
+
+func (v *invocation_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *invocation_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *invocation_) GetClass() InvocationClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *invocation_) getClass() *invocationClass_ {
+	return invocationReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type invocation_ struct {
+	// Declare the instance attributes.
+	optionalExpression_    ExpressionLike
+	additionalExpressions_ abs.Sequential[AdditionalExpressionLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type invocationClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func invocationReference() *invocationClass_ {
+	return invocationReference_
+}
+
+var invocationReference_ = &invocationClass_{
+	// Initialize the class constants.
+}
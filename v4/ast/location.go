@@ -0,0 +1,106 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Location() LocationClassLike {
+	return locationReference()
+}
+
+// Constructor Methods
+
+func (c *locationClass_) Make(
+	line uint,
+	column uint,
+	offset uint,
+) LocationLike {
+	if uti.IsUndefined(line) {
+		panic("The \"line\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(column) {
+		panic("The \"column\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(offset) {
+		panic("The \"offset\" attribute is required by this class.")
+	}
+	var instance = &location_{
+		// Initialize the instance attributes.
+		line_:   line,
+		column_: column,
+		offset_: offset,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *location_) GetLine() uint {
+	return v.line_
+}
+
+func (v *location_) GetColumn() uint {
+	return v.column_
+}
+
+func (v *location_) GetOffset() uint {
+	return v.offset_
+}
+
+// Public Methods
+
+func (v *location_) GetClass() LocationClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *location_) getClass() *locationClass_ {
+	return locationReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type location_ struct {
+	// Declare the instance attributes.
+	line_   uint
+	column_ uint
+	offset_ uint
+}
+
+// Class Structure
+
+type locationClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func locationReference() *locationClass_ {
+	return locationReference_
+}
+
+var locationReference_ = &locationClass_{
+	// Initialize the class constants.
+}
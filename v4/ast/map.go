@@ -48,6 +48,18 @@ func (v *map_) GetName() string {
 	return v.name_
 }
 
+// Spanned Methods
+
+func (v *map_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *map_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *map_) GetClass() MapClassLike {
@@ -67,6 +79,9 @@ func (v *map_) getClass() *mapClass_ {
 type map_ struct {
 	// Declare the instance attributes.
 	name_ string
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
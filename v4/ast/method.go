@@ -28,6 +28,7 @@ func Method() MethodClassLike {
 // Constructor Methods
 
 func (c *methodClass_) Make(
+	optionalNote string,
 	name string,
 	parameters abs.Sequential[ParameterLike],
 	optionalResult ResultLike,
@@ -40,6 +41,7 @@ func (c *methodClass_) Make(
 	}
 	var instance = &method_{
 		// Initialize the instance attributes.
+		optionalNote_:   optionalNote,
 		name_:           name,
 		parameters_:     parameters,
 		optionalResult_: optionalResult,
@@ -52,6 +54,10 @@ func (c *methodClass_) Make(
 
 // Attribute Methods
 
+func (v *method_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *method_) GetName() string {
 	return v.name_
 }
@@ -64,6 +70,18 @@ func (v *method_) GetOptionalResult() ResultLike {
 	return v.optionalResult_
 }
 
+// Spanned Methods
+
+func (v *method_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *method_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *method_) GetClass() MethodClassLike {
@@ -82,9 +100,13 @@ func (v *method_) getClass() *methodClass_ {
 
 type method_ struct {
 	// Declare the instance attributes.
+	optionalNote_   string
 	name_           string
 	parameters_     abs.Sequential[ParameterLike]
 	optionalResult_ ResultLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -66,6 +66,18 @@ func (v *model_) GetInterfaceDefinitions() InterfaceDefinitionsLike {
 	return v.interfaceDefinitions_
 }
 
+// Spanned Methods
+
+func (v *model_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *model_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *model_) GetClass() ModelClassLike {
@@ -87,6 +99,9 @@ type model_ struct {
 	moduleDefinition_     ModuleDefinitionLike
 	primitiveDefinitions_ PrimitiveDefinitionsLike
 	interfaceDefinitions_ InterfaceDefinitionsLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
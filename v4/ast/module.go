@@ -27,19 +27,16 @@ func Module() ModuleClassLike {
 // Constructor Methods
 
 func (c *moduleClass_) Make(
-	name string,
+	optionalAlias AliasLike,
 	path string,
 ) ModuleLike {
-	if uti.IsUndefined(name) {
-		panic("The \"name\" attribute is required by this class.")
-	}
 	if uti.IsUndefined(path) {
 		panic("The \"path\" attribute is required by this class.")
 	}
 	var instance = &module_{
 		// Initialize the instance attributes.
-		name_: name,
-		path_: path,
+		optionalAlias_: optionalAlias,
+		path_:          path,
 	}
 	return instance
 
@@ -49,14 +46,26 @@ func (c *moduleClass_) Make(
 
 // Attribute Methods
 
-func (v *module_) GetName() string {
-	return v.name_
+func (v *module_) GetOptionalAlias() AliasLike {
+	return v.optionalAlias_
 }
 
 func (v *module_) GetPath() string {
 	return v.path_
 }
 
+// Spanned Methods
+
+func (v *module_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *module_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *module_) GetClass() ModuleClassLike {
@@ -75,8 +84,11 @@ func (v *module_) getClass() *moduleClass_ {
 
 type module_ struct {
 	// Declare the instance attributes.
-	name_ string
-	path_ string
+	optionalAlias_ AliasLike
+	path_          string
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
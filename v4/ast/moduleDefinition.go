@@ -63,6 +63,18 @@ func (v *moduleDefinition_) GetOptionalImports() ImportsLike {
 	return v.optionalImports_
 }
 
+// Spanned Methods
+
+func (v *moduleDefinition_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *moduleDefinition_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *moduleDefinition_) GetClass() ModuleDefinitionClassLike {
@@ -84,6 +96,9 @@ type moduleDefinition_ struct {
 	notice_          NoticeLike
 	header_          HeaderLike
 	optionalImports_ ImportsLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
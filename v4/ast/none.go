@@ -48,6 +48,18 @@ func (v *none_) GetNewline() string {
 	return v.newline_
 }
 
+// Spanned Methods
+
+func (v *none_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *none_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *none_) GetClass() NoneClassLike {
@@ -67,6 +79,9 @@ func (v *none_) getClass() *noneClass_ {
 type none_ struct {
 	// Declare the instance attributes.
 	newline_ string
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
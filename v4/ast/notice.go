@@ -48,6 +48,18 @@ func (v *notice_) GetComment() string {
 	return v.comment_
 }
 
+// Spanned Methods
+
+func (v *notice_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *notice_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *notice_) GetClass() NoticeClassLike {
@@ -67,6 +79,9 @@ func (v *notice_) getClass() *noticeClass_ {
 type notice_ struct {
 	// Declare the instance attributes.
 	comment_ string
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
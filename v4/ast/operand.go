@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Operand() OperandClassLike {
+	return operandReference()
+}
+
+// Constructor Methods
+
+func (c *operandClass_) Make(
+	any_ any,
+) OperandLike {
+	if uti.IsUndefined(any_) {
+		panic("The \"any_\" attribute is required by this class.")
+	}
+	var instance = &operand_{
+		// Initialize the instance attributes.
+		any__: any_,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *operand_) GetAny() any {
+	return v.any__
+}
+
+// Spanned Methods
+
+func (v *operand_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *operand_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *operand_) GetClass() OperandClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *operand_) getClass() *operandClass_ {
+	return operandReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type operand_ struct {
+	// Declare the instance attributes.
+	any__ any
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type operandClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func operandReference() *operandClass_ {
+	return operandReference_
+}
+
+var operandReference_ = &operandClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,111 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Operation() OperationClassLike {
+	return operationReference()
+}
+
+// Constructor Methods
+
+func (c *operationClass_) Make(
+	operator OperatorLike,
+	operand OperandLike,
+) OperationLike {
+	if uti.IsUndefined(operator) {
+		panic("The \"operator\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(operand) {
+		panic("The \"operand\" attribute is required by this class.")
+	}
+	var instance = &operation_{
+		// Initialize the instance attributes.
+		operator_: operator,
+		operand_:  operand,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *operation_) GetOperator() OperatorLike {
+	return v.operator_
+}
+
+func (v *operation_) GetOperand() OperandLike {
+	return v.operand_
+}
+
+// Spanned Methods
+
+func (v *operation_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *operation_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *operation_) GetClass() OperationClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *operation_) getClass() *operationClass_ {
+	return operationReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type operation_ struct {
+	// Declare the instance attributes.
+	operator_ OperatorLike
+	operand_  OperandLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type operationClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func operationReference() *operationClass_ {
+	return operationReference_
+}
+
+var operationReference_ = &operationClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Operator() OperatorClassLike {
+	return operatorReference()
+}
+
+// Constructor Methods
+
+func (c *operatorClass_) Make(
+	any_ any,
+) OperatorLike {
+	if uti.IsUndefined(any_) {
+		panic("The \"any_\" attribute is required by this class.")
+	}
+	var instance = &operator_{
+		// Initialize the instance attributes.
+		any__: any_,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *operator_) GetAny() any {
+	return v.any__
+}
+
+// Spanned Methods
+
+func (v *operator_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *operator_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *operator_) GetClass() OperatorClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *operator_) getClass() *operatorClass_ {
+	return operatorReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type operator_ struct {
+	// Declare the instance attributes.
+	any__ any
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type operatorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func operatorReference() *operatorClass_ {
+	return operatorReference_
+}
+
+var operatorReference_ = &operatorClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,111 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Output() OutputClassLike {
+	return outputReference()
+}
+
+// Constructor Methods
+
+func (c *outputClass_) Make(
+	name string,
+	abstraction AbstractionLike,
+) OutputLike {
+	if uti.IsUndefined(name) {
+		panic("The \"name\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(abstraction) {
+		panic("The \"abstraction\" attribute is required by this class.")
+	}
+	var instance = &output_{
+		// Initialize the instance attributes.
+		name_:        name,
+		abstraction_: abstraction,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *output_) GetName() string {
+	return v.name_
+}
+
+func (v *output_) GetAbstraction() AbstractionLike {
+	return v.abstraction_
+}
+
+// Spanned Methods
+
+func (v *output_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *output_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *output_) GetClass() OutputClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *output_) getClass() *outputClass_ {
+	return outputReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type output_ struct {
+	// Declare the instance attributes.
+	name_        string
+	abstraction_ AbstractionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type outputClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func outputReference() *outputClass_ {
+	return outputReference_
+}
+
+var outputReference_ = &outputClass_{
+	// Initialize the class constants.
+}
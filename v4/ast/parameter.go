@@ -28,6 +28,7 @@ func Parameter() ParameterClassLike {
 
 func (c *parameterClass_) Make(
 	name string,
+	variadic bool,
 	abstraction AbstractionLike,
 ) ParameterLike {
 	if uti.IsUndefined(name) {
@@ -39,6 +40,7 @@ func (c *parameterClass_) Make(
 	var instance = &parameter_{
 		// Initialize the instance attributes.
 		name_:        name,
+		variadic_:    variadic,
 		abstraction_: abstraction,
 	}
 	return instance
@@ -53,10 +55,26 @@ func (v *parameter_) GetName() string {
 	return v.name_
 }
 
+func (v *parameter_) IsVariadic() bool {
+	return v.variadic_
+}
+
 func (v *parameter_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
+// Spanned Methods
+
+func (v *parameter_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *parameter_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *parameter_) GetClass() ParameterClassLike {
@@ -76,7 +94,11 @@ func (v *parameter_) getClass() *parameterClass_ {
 type parameter_ struct {
 	// Declare the instance attributes.
 	name_        string
+	variadic_    bool
 	abstraction_ AbstractionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
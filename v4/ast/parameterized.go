@@ -28,14 +28,19 @@ func Parameterized() ParameterizedClassLike {
 // Constructor Methods
 
 func (c *parameterizedClass_) Make(
-	parameters abs.Sequential[ParameterLike],
+	output OutputLike,
+	additionalOutputs abs.Sequential[AdditionalOutputLike],
 ) ParameterizedLike {
-	if uti.IsUndefined(parameters) {
-		panic("The \"parameters\" attribute is required by this class.")
+	if uti.IsUndefined(output) {
+		panic("The \"output\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(additionalOutputs) {
+		panic("The \"additionalOutputs\" attribute is required by this class.")
 	}
 	var instance = &parameterized_{
 		// Initialize the instance attributes.
-		parameters_: parameters,
+		output_:            output,
+		additionalOutputs_: additionalOutputs,
 	}
 	return instance
 
@@ -45,8 +50,24 @@ func (c *parameterizedClass_) Make(
 
 // Attribute Methods
 
-func (v *parameterized_) GetParameters() abs.Sequential[ParameterLike] {
-	return v.parameters_
+func (v *parameterized_) GetOutput() OutputLike {
+	return v.output_
+}
+
+func (v *parameterized_) GetAdditionalOutputs() abs.Sequential[AdditionalOutputLike] {
+	return v.additionalOutputs_
+}
+
+// Spanned Methods
+
+func (v *parameterized_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *parameterized_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
 }
 
 // Public Methods
@@ -67,7 +88,11 @@ func (v *parameterized_) getClass() *parameterizedClass_ {
 
 type parameterized_ struct {
 	// Declare the instance attributes.
-	parameters_ abs.Sequential[ParameterLike]
+	output_            OutputLike
+	additionalOutputs_ abs.Sequential[AdditionalOutputLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
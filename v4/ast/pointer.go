@@ -0,0 +1,84 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Pointer() PointerClassLike {
+	return pointerReference()
+}
+
+// Constructor Methods
+
+func (c *pointerClass_) Make() PointerLike {
+	var instance = &pointer_{
+		// Initialize the instance attributes.
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Spanned Methods
+
+func (v *pointer_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *pointer_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *pointer_) GetClass() PointerClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *pointer_) getClass() *pointerClass_ {
+	return pointerReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type pointer_ struct {
+	// Declare the instance attributes.
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type pointerClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func pointerReference() *pointerClass_ {
+	return pointerReference_
+}
+
+var pointerReference_ = &pointerClass_{
+	// Initialize the class constants.
+}
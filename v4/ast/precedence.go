@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Precedence() PrecedenceClassLike {
+	return precedenceReference()
+}
+
+// Constructor Methods
+
+func (c *precedenceClass_) Make(
+	expression ExpressionLike,
+) PrecedenceLike {
+	if uti.IsUndefined(expression) {
+		panic("The \"expression\" attribute is required by this class.")
+	}
+	var instance = &precedence_{
+		// Initialize the instance attributes.
+		expression_: expression,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *precedence_) GetExpression() ExpressionLike {
+	return v.expression_
+}
+
+// Spanned Methods
+
+func (v *precedence_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *precedence_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *precedence_) GetClass() PrecedenceClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *precedence_) getClass() *precedenceClass_ {
+	return precedenceReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type precedence_ struct {
+	// Declare the instance attributes.
+	expression_ ExpressionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type precedenceClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func precedenceReference() *precedenceClass_ {
+	return precedenceReference_
+}
+
+var precedenceReference_ = &precedenceClass_{
+	// Initialize the class constants.
+}
@@ -48,6 +48,18 @@ func (v *prefix_) GetAny() any {
 	return v.any_
 }
 
+// Spanned Methods
+
+func (v *prefix_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *prefix_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *prefix_) GetClass() PrefixClassLike {
@@ -67,6 +79,9 @@ func (v *prefix_) getClass() *prefixClass_ {
 type prefix_ struct {
 	// Declare the instance attributes.
 	any_ any
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -25,11 +25,13 @@ func PrimitiveDefinitions() PrimitiveDefinitionsClassLike {
 func (c *primitiveDefinitionsClass_) Make(
 	optionalTypeSection TypeSectionLike,
 	optionalFunctionalSection FunctionalSectionLike,
+	optionalConstantSection ConstantSectionLike,
 ) PrimitiveDefinitionsLike {
 	var instance = &primitiveDefinitions_{
 		// Initialize the instance attributes.
 		optionalTypeSection_:       optionalTypeSection,
 		optionalFunctionalSection_: optionalFunctionalSection,
+		optionalConstantSection_:   optionalConstantSection,
 	}
 	return instance
 
@@ -47,6 +49,22 @@ func (v *primitiveDefinitions_) GetOptionalFunctionalSection() FunctionalSection
 	return v.optionalFunctionalSection_
 }
 
+func (v *primitiveDefinitions_) GetOptionalConstantSection() ConstantSectionLike {
+	return v.optionalConstantSection_
+}
+
+// Spanned Methods
+
+func (v *primitiveDefinitions_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *primitiveDefinitions_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *primitiveDefinitions_) GetClass() PrimitiveDefinitionsClassLike {
@@ -67,6 +85,10 @@ type primitiveDefinitions_ struct {
 	// Declare the instance attributes.
 	optionalTypeSection_       TypeSectionLike
 	optionalFunctionalSection_ FunctionalSectionLike
+	optionalConstantSection_   ConstantSectionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -48,6 +48,18 @@ func (v *publicMethod_) GetMethod() MethodLike {
 	return v.method_
 }
 
+// Spanned Methods
+
+func (v *publicMethod_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *publicMethod_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *publicMethod_) GetClass() PublicMethodClassLike {
@@ -67,6 +79,9 @@ func (v *publicMethod_) getClass() *publicMethodClass_ {
 type publicMethod_ struct {
 	// Declare the instance attributes.
 	method_ MethodLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
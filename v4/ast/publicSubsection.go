@@ -49,6 +49,18 @@ func (v *publicSubsection_) GetPublicMethods() abs.Sequential[PublicMethodLike]
 	return v.publicMethods_
 }
 
+// Spanned Methods
+
+func (v *publicSubsection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *publicSubsection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *publicSubsection_) GetClass() PublicSubsectionClassLike {
@@ -68,6 +80,9 @@ func (v *publicSubsection_) getClass() *publicSubsectionClass_ {
 type publicSubsection_ struct {
 	// Declare the instance attributes.
 	publicMethods_ abs.Sequential[PublicMethodLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Qualifier() QualifierClassLike {
+	return qualifierReference()
+}
+
+// Constructor Methods
+
+func (c *qualifierClass_) Make(
+	any_ any,
+) QualifierLike {
+	if uti.IsUndefined(any_) {
+		panic("The \"any_\" attribute is required by this class.")
+	}
+	var instance = &qualifier_{
+		// Initialize the instance attributes.
+		any__: any_,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *qualifier_) GetAny() any {
+	return v.any__
+}
+
+// Spanned Methods
+
+func (v *qualifier_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *qualifier_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *qualifier_) GetClass() QualifierClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *qualifier_) getClass() *qualifierClass_ {
+	return qualifierReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type qualifier_ struct {
+	// Declare the instance attributes.
+	any__ any
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type qualifierClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func qualifierReference() *qualifierClass_ {
+	return qualifierReference_
+}
+
+var qualifierReference_ = &qualifierClass_{
+	// Initialize the class constants.
+}
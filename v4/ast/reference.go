@@ -0,0 +1,115 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Reference() ReferenceClassLike {
+	return referenceReference()
+}
+
+// Constructor Methods
+
+func (c *referenceClass_) Make(
+	name string,
+	optionalSuffix SuffixLike,
+	optionalInvocation InvocationLike,
+) ReferenceLike {
+	if uti.IsUndefined(name) {
+		panic("The \"name\" attribute is required by this class.")
+	}
+	var instance = &reference_{
+		// Initialize the instance attributes.
+		name_:               name,
+		optionalSuffix_:     optionalSuffix,
+		optionalInvocation_: optionalInvocation,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *reference_) GetName() string {
+	return v.name_
+}
+
+func (v *reference_) GetOptionalSuffix() SuffixLike {
+	return v.optionalSuffix_
+}
+
+func (v *reference_) GetOptionalInvocation() InvocationLike {
+	return v.optionalInvocation_
+}
+
+// Spanned Methods
+
+func (v *reference_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *reference_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *reference_) GetClass() ReferenceClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *reference_) getClass() *referenceClass_ {
+	return referenceReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type reference_ struct {
+	// Declare the instance attributes.
+	name_               string
+	optionalSuffix_     SuffixLike
+	optionalInvocation_ InvocationLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type referenceClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func referenceReference() *referenceClass_ {
+	return referenceReference_
+}
+
+var referenceReference_ = &referenceClass_{
+	// Initialize the class constants.
+}
@@ -48,6 +48,18 @@ func (v *result_) GetAny() any {
 	return v.any_
 }
 
+// Spanned Methods
+
+func (v *result_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *result_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *result_) GetClass() ResultClassLike {
@@ -67,6 +79,9 @@ func (v *result_) getClass() *resultClass_ {
 type result_ struct {
 	// Declare the instance attributes.
 	any_ any
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
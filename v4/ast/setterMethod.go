@@ -27,6 +27,7 @@ func SetterMethod() SetterMethodClassLike {
 // Constructor Methods
 
 func (c *setterMethodClass_) Make(
+	optionalNote string,
 	name string,
 	parameter ParameterLike,
 ) SetterMethodLike {
@@ -38,8 +39,9 @@ func (c *setterMethodClass_) Make(
 	}
 	var instance = &setterMethod_{
 		// Initialize the instance attributes.
-		name_:      name,
-		parameter_: parameter,
+		optionalNote_: optionalNote,
+		name_:         name,
+		parameter_:    parameter,
 	}
 	return instance
 
@@ -49,6 +51,10 @@ func (c *setterMethodClass_) Make(
 
 // Attribute Methods
 
+func (v *setterMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *setterMethod_) GetName() string {
 	return v.name_
 }
@@ -57,6 +63,18 @@ func (v *setterMethod_) GetParameter() ParameterLike {
 	return v.parameter_
 }
 
+// Spanned Methods
+
+func (v *setterMethod_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *setterMethod_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *setterMethod_) GetClass() SetterMethodClassLike {
@@ -75,8 +93,12 @@ func (v *setterMethod_) getClass() *setterMethodClass_ {
 
 type setterMethod_ struct {
 	// Declare the instance attributes.
-	name_      string
-	parameter_ ParameterLike
+	optionalNote_ string
+	name_         string
+	parameter_    ParameterLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
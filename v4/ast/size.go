@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Size() SizeClassLike {
+	return sizeReference()
+}
+
+// Constructor Methods
+
+func (c *sizeClass_) Make(
+	any_ any,
+) SizeLike {
+	if uti.IsUndefined(any_) {
+		panic("The \"any_\" attribute is required by this class.")
+	}
+	var instance = &size_{
+		// Initialize the instance attributes.
+		any__: any_,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *size_) GetAny() any {
+	return v.any__
+}
+
+// Spanned Methods
+
+func (v *size_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *size_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *size_) GetClass() SizeClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *size_) getClass() *sizeClass_ {
+	return sizeReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type size_ struct {
+	// Declare the instance attributes.
+	any__ any
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type sizeClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func sizeReference() *sizeClass_ {
+	return sizeReference_
+}
+
+var sizeReference_ = &sizeClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,95 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Span() SpanClassLike {
+	return spanReference()
+}
+
+// Constructor Methods
+
+func (c *spanClass_) Make(
+	start LocationLike,
+	end LocationLike,
+) SpanLike {
+	if uti.IsUndefined(start) {
+		panic("The \"start\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(end) {
+		panic("The \"end\" attribute is required by this class.")
+	}
+	var instance = &span_{
+		// Initialize the instance attributes.
+		start_: start,
+		end_:   end,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *span_) GetStart() LocationLike {
+	return v.start_
+}
+
+func (v *span_) GetEnd() LocationLike {
+	return v.end_
+}
+
+// Public Methods
+
+func (v *span_) GetClass() SpanClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *span_) getClass() *spanClass_ {
+	return spanReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type span_ struct {
+	// Declare the instance attributes.
+	start_ LocationLike
+	end_   LocationLike
+}
+
+// Class Structure
+
+type spanClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func spanReference() *spanClass_ {
+	return spanReference_
+}
+
+var spanReference_ = &spanClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,102 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Structure() StructureClassLike {
+	return structureReference()
+}
+
+// Constructor Methods
+
+func (c *structureClass_) Make(
+	fields abs.Sequential[FieldLike],
+) StructureLike {
+	if uti.IsUndefined(fields) {
+		panic("The \"fields\" attribute is required by this class.")
+	}
+	var instance = &structure_{
+		// Initialize the instance attributes.
+		fields_: fields,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *structure_) GetFields() abs.Sequential[FieldLike] {
+	return v.fields_
+}
+
+// Spanned Methods
+
+func (v *structure_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *structure_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *structure_) GetClass() StructureClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *structure_) getClass() *structureClass_ {
+	return structureReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type structure_ struct {
+	// Declare the instance attributes.
+	fields_ abs.Sequential[FieldLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type structureClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func structureReference() *structureClass_ {
+	return structureReference_
+}
+
+var structureReference_ = &structureClass_{
+	// Initialize the class constants.
+}
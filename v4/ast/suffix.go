@@ -48,6 +48,18 @@ func (v *suffix_) GetName() string {
 	return v.name_
 }
 
+// Spanned Methods
+
+func (v *suffix_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *suffix_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *suffix_) GetClass() SuffixClassLike {
@@ -67,6 +79,9 @@ func (v *suffix_) getClass() *suffixClass_ {
 type suffix_ struct {
 	// Declare the instance attributes.
 	name_ string
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
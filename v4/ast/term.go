@@ -0,0 +1,108 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Term() TermClassLike {
+	return termReference()
+}
+
+// Constructor Methods
+
+func (c *termClass_) Make(
+	approximate bool,
+	abstraction AbstractionLike,
+) TermLike {
+	if uti.IsUndefined(abstraction) {
+		panic("The \"abstraction\" attribute is required by this class.")
+	}
+	var instance = &term_{
+		// Initialize the instance attributes.
+		approximate_: approximate,
+		abstraction_: abstraction,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *term_) IsApproximate() bool {
+	return v.approximate_
+}
+
+func (v *term_) GetAbstraction() AbstractionLike {
+	return v.abstraction_
+}
+
+// Spanned Methods
+
+func (v *term_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *term_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *term_) GetClass() TermClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *term_) getClass() *termClass_ {
+	return termReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type term_ struct {
+	// Declare the instance attributes.
+	approximate_ bool
+	abstraction_ AbstractionLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type termClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func termReference() *termClass_ {
+	return termReference_
+}
+
+var termReference_ = &termClass_{
+	// Initialize the class constants.
+}
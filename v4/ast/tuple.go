@@ -0,0 +1,112 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Tuple() TupleClassLike {
+	return tupleReference()
+}
+
+// Constructor Methods
+
+func (c *tupleClass_) Make(
+	argument ArgumentLike,
+	additionalArguments abs.Sequential[AdditionalArgumentLike],
+) TupleLike {
+	if uti.IsUndefined(argument) {
+		panic("The \"argument\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(additionalArguments) {
+		panic("The \"additionalArguments\" attribute is required by this class.")
+	}
+	var instance = &tuple_{
+		// Initialize the instance attributes.
+		argument_:            argument,
+		additionalArguments_: additionalArguments,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *tuple_) GetArgument() ArgumentLike {
+	return v.argument_
+}
+
+func (v *tuple_) GetAdditionalArguments() abs.Sequential[AdditionalArgumentLike] {
+	return v.additionalArguments_
+}
+
+// Spanned Methods
+
+func (v *tuple_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *tuple_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *tuple_) GetClass() TupleClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *tuple_) getClass() *tupleClass_ {
+	return tupleReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type tuple_ struct {
+	// Declare the instance attributes.
+	argument_            ArgumentLike
+	additionalArguments_ abs.Sequential[AdditionalArgumentLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type tupleClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func tupleReference() *tupleClass_ {
+	return tupleReference_
+}
+
+var tupleReference_ = &tupleClass_{
+	// Initialize the class constants.
+}
@@ -28,19 +28,19 @@ func TypeDefinition() TypeDefinitionClassLike {
 
 func (c *typeDefinitionClass_) Make(
 	declaration DeclarationLike,
-	abstraction AbstractionLike,
+	underlying UnderlyingLike,
 	optionalEnumeration EnumerationLike,
 ) TypeDefinitionLike {
 	if uti.IsUndefined(declaration) {
 		panic("The \"declaration\" attribute is required by this class.")
 	}
-	if uti.IsUndefined(abstraction) {
-		panic("The \"abstraction\" attribute is required by this class.")
+	if uti.IsUndefined(underlying) {
+		panic("The \"underlying\" attribute is required by this class.")
 	}
 	var instance = &typeDefinition_{
 		// Initialize the instance attributes.
 		declaration_:         declaration,
-		abstraction_:         abstraction,
+		underlying_:          underlying,
 		optionalEnumeration_: optionalEnumeration,
 	}
 	return instance
@@ -55,14 +55,26 @@ func (v *typeDefinition_) GetDeclaration() DeclarationLike {
 	return v.declaration_
 }
 
-func (v *typeDefinition_) GetAbstraction() AbstractionLike {
-	return v.abstraction_
+func (v *typeDefinition_) GetUnderlying() UnderlyingLike {
+	return v.underlying_
 }
 
 func (v *typeDefinition_) GetOptionalEnumeration() EnumerationLike {
 	return v.optionalEnumeration_
 }
 
+// Spanned Methods
+
+func (v *typeDefinition_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *typeDefinition_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *typeDefinition_) GetClass() TypeDefinitionClassLike {
@@ -82,8 +94,11 @@ func (v *typeDefinition_) getClass() *typeDefinitionClass_ {
 type typeDefinition_ struct {
 	// Declare the instance attributes.
 	declaration_         DeclarationLike
-	abstraction_         AbstractionLike
+	underlying_          UnderlyingLike
 	optionalEnumeration_ EnumerationLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
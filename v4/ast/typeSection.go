@@ -49,6 +49,18 @@ func (v *typeSection_) GetTypeDefinitions() abs.Sequential[TypeDefinitionLike] {
 	return v.typeDefinitions_
 }
 
+// Spanned Methods
+
+func (v *typeSection_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *typeSection_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *typeSection_) GetClass() TypeSectionClassLike {
@@ -68,6 +80,9 @@ func (v *typeSection_) getClass() *typeSectionClass_ {
 type typeSection_ struct {
 	// Declare the instance attributes.
 	typeDefinitions_ abs.Sequential[TypeDefinitionLike]
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -0,0 +1,111 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Unary() UnaryClassLike {
+	return unaryReference()
+}
+
+// Constructor Methods
+
+func (c *unaryClass_) Make(
+	operator string,
+	operand OperandLike,
+) UnaryLike {
+	if uti.IsUndefined(operator) {
+		panic("The \"operator\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(operand) {
+		panic("The \"operand\" attribute is required by this class.")
+	}
+	var instance = &unary_{
+		// Initialize the instance attributes.
+		operator_: operator,
+		operand_:  operand,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *unary_) GetOperator() string {
+	return v.operator_
+}
+
+func (v *unary_) GetOperand() OperandLike {
+	return v.operand_
+}
+
+// Spanned Methods
+
+func (v *unary_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *unary_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *unary_) GetClass() UnaryClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *unary_) getClass() *unaryClass_ {
+	return unaryReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type unary_ struct {
+	// Declare the instance attributes.
+	operator_ string
+	operand_  OperandLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type unaryClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func unaryReference() *unaryClass_ {
+	return unaryReference_
+}
+
+var unaryReference_ = &unaryClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Underlying() UnderlyingClassLike {
+	return underlyingReference()
+}
+
+// Constructor Methods
+
+func (c *underlyingClass_) Make(
+	any_ any,
+) UnderlyingLike {
+	if uti.IsUndefined(any_) {
+		panic("The \"any_\" attribute is required by this class.")
+	}
+	var instance = &underlying_{
+		// Initialize the instance attributes.
+		any__: any_,
+	}
+	return instance
+
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *underlying_) GetAny() any {
+	return v.any__
+}
+
+// Spanned Methods
+
+func (v *underlying_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *underlying_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
+// Public Methods
+
+func (v *underlying_) GetClass() UnderlyingClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *underlying_) getClass() *underlyingClass_ {
+	return underlyingReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type underlying_ struct {
+	// Declare the instance attributes.
+	any__ any
+
+	// Declare the aspect attributes.
+	span_ SpanLike
+}
+
+// Class Structure
+
+type underlyingClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func underlyingReference() *underlyingClass_ {
+	return underlyingReference_
+}
+
+var underlyingReference_ = &underlyingClass_{
+	// Initialize the class constants.
+}
@@ -29,6 +29,7 @@ func Value() ValueClassLike {
 func (c *valueClass_) Make(
 	name string,
 	abstraction AbstractionLike,
+	initializer InitializerLike,
 ) ValueLike {
 	if uti.IsUndefined(name) {
 		panic("The \"name\" attribute is required by this class.")
@@ -36,10 +37,14 @@ func (c *valueClass_) Make(
 	if uti.IsUndefined(abstraction) {
 		panic("The \"abstraction\" attribute is required by this class.")
 	}
+	if uti.IsUndefined(initializer) {
+		panic("The \"initializer\" attribute is required by this class.")
+	}
 	var instance = &value_{
 		// Initialize the instance attributes.
 		name_:        name,
 		abstraction_: abstraction,
+		initializer_: initializer,
 	}
 	return instance
 
@@ -57,6 +62,22 @@ func (v *value_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
+func (v *value_) GetInitializer() InitializerLike {
+	return v.initializer_
+}
+
+// Spanned Methods
+
+func (v *value_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *value_) SetSpan(
+	span SpanLike,
+) {
+	v.span_ = span
+}
+
 // Public Methods
 
 func (v *value_) GetClass() ValueClassLike {
@@ -77,6 +98,10 @@ type value_ struct {
 	// Declare the instance attributes.
 	name_        string
 	abstraction_ AbstractionLike
+	initializer_ InitializerLike
+
+	// Declare the aspect attributes.
+	span_ SpanLike
 }
 
 // Class Structure
@@ -15,6 +15,10 @@ Package "generator" provides the ability to generate Go class files based on a
 Go Package.go file that follows the format shown in the following code template:
   - https://github.com/craterdog/go-model-framework/blob/main/models/Package.go
 
+The structures and constants declared by a model are generated into their own
+files, so the Package.go file that is compiled alongside them is generated
+without them.
+
 This package follows the Crater Dog Technologies™ Go Coding Conventions located
 here:
   - https://github.com/craterdog/go-model-framework/wiki
@@ -29,6 +33,7 @@ package generator
 import (
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	ast "github.com/craterdog/go-model-framework/v4/ast"
+	gra "github.com/craterdog/go-model-framework/v4/grammar"
 )
 
 // Class Definitions
@@ -41,6 +46,9 @@ concrete classes-like class.
 type ClassesClassLike interface {
 	// Constructor Methods
 	Make() ClassesLike
+	MakeWithWorkspace(
+		workspace gra.WorkspaceLike,
+	) ClassesLike
 }
 
 // Instance Definitions
@@ -56,4 +64,13 @@ type ClassesLike interface {
 	GenerateModelClasses(
 		model ast.ModelLike,
 	) abs.CatalogLike[string, string]
+	GenerateModelConstants(
+		model ast.ModelLike,
+	) string
+	GenerateModelPackage(
+		model ast.ModelLike,
+	) string
+	GenerateModelStructures(
+		model ast.ModelLike,
+	) abs.CatalogLike[string, string]
 }
@@ -14,10 +14,15 @@ package generator_test
 
 import (
 	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v4"
+	ast "github.com/craterdog/go-model-framework/v4/ast"
 	gen "github.com/craterdog/go-model-framework/v4/generator"
 	gra "github.com/craterdog/go-model-framework/v4/grammar"
 	ass "github.com/stretchr/testify/assert"
 	osx "os"
+	exe "os/exec"
+	fil "path/filepath"
+	sts "strings"
 	tes "testing"
 )
 
@@ -57,6 +62,707 @@ func TestRoundTrips(t *tes.T) {
 				panic(err)
 			}
 		}
+		var structures = generator.GenerateModelStructures(model).GetIterator()
+		for structures.HasNext() {
+			var association = structures.GetNext()
+			var structureName = association.GetKey()
+			var structureSource = association.GetValue()
+			bytes = []byte(structureSource)
+			var filename = directory + structureName + ".go"
+			err = osx.WriteFile(filename, bytes, 0644)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 	fmt.Println("Done.")
 }
+
+const constantsSource = `/*
+ Notice
+*/
+
+/*
+Package "example" is a class model.
+*/
+package example
+
+import (
+	"errors"
+)
+
+// Type Definitions
+
+/*
+Color is a constrained type representing a named color.
+*/
+type Color string
+
+const (
+	Red Color = "red"
+	Green Color = "green"
+	Blue = "blue"
+)
+
+/*
+Mask is a constrained type representing a set of masked bits.
+*/
+type Mask uint16
+
+const (
+	Low Mask = 0x1F
+	High = (Low | 0o740) &^ 0b11
+)
+
+// Constant Definitions
+
+/*
+MaxDepth is the maximum depth of a nested model.
+*/
+const MaxDepth int = 1 << 5
+
+/*
+MinDepth is the minimum depth of a nested model.
+*/
+const MinDepth = -(MaxDepth >> 1)
+
+/*
+ErrNotFound is returned when a model cannot be found.
+*/
+var ErrNotFound = errors.New("not found")
+
+// Class Definitions
+
+/*
+BetaClassLike is a class interface.
+*/
+type BetaClassLike interface {
+	// Constructor Methods
+	Make() BetaLike
+}
+
+// Instance Definitions
+
+/*
+BetaLike is an instance interface.
+*/
+type BetaLike interface {
+	// Public Methods
+	GetClass() BetaClassLike
+}
+`
+
+func TestModelConstants(t *tes.T) {
+	// The constants are generated into their own file and left out of the
+	// package that is compiled with it.
+	compileModel(t, constantsSource, `package example
+
+import (
+	fmt "fmt"
+	tes "testing"
+)
+
+func TestConstants(t *tes.T) {
+	if MaxDepth != 32 || MinDepth != -16 || ErrNotFound.Error() != "not found" {
+		t.Error("The constants should be declared by the constants file.")
+	}
+	if fmt.Sprintf("%T %T", Green, Blue) != "example.Color string" {
+		t.Error("Only a value that declares its type should be typed.")
+	}
+	if High != 0x1FC {
+		t.Error("The initial values should be evaluated by Go.")
+	}
+}
+`)
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(constantsSource)
+	var generator = gen.Classes().Make()
+	var constants = generator.GenerateModelConstants(model)
+	ass.Equal(t, `/*
+ Notice
+*/
+
+package example
+
+import (
+	"errors"
+)
+
+// Constant Definitions
+
+/*
+MaxDepth is the maximum depth of a nested model.
+*/
+const MaxDepth int = 1 << 5
+
+/*
+MinDepth is the minimum depth of a nested model.
+*/
+const MinDepth = -(MaxDepth >> 1)
+
+/*
+ErrNotFound is returned when a model cannot be found.
+*/
+var ErrNotFound = errors.New("not found")
+`, constants)
+	var source = generator.GenerateModelPackage(model)
+	ass.False(t, sts.Contains(source, "// Constant Definitions"))
+	ass.False(t, sts.Contains(source, "\"errors\""))
+}
+
+const structuresSource = `/*
+ Notice
+*/
+
+/*
+Package "example" is a class model.
+*/
+package example
+
+import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+)
+
+// Type Definitions
+
+/*
+Options is a structure containing the options for generating a model.
+*/
+type Options struct {
+	Name    string ` + "`json:\"name\"`" + `
+	Type    uint8
+	Modules abs.ListLike[string] ` + "`json:\"modules,omitempty\"`" + `
+}
+
+// Class Definitions
+
+/*
+BetaClassLike is a class interface.
+*/
+type BetaClassLike interface {
+	// Constructor Methods
+	Make() BetaLike
+}
+
+// Instance Definitions
+
+/*
+BetaLike is an instance interface.
+*/
+type BetaLike interface {
+	// Public Methods
+	GetClass() BetaClassLike
+}
+`
+
+func TestModelStructures(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(structuresSource)
+	var generator = gen.Classes().Make()
+	var structures = generator.GenerateModelStructures(model)
+	ass.Equal(t, 1, structures.GetSize())
+	ass.Equal(t, `/*
+ Notice
+*/
+
+package example
+
+import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+)
+
+// Structure Definition
+
+/*
+Options is a structure containing the options for generating a model.
+*/
+type Options struct {
+	Name    string `+"`json:\"name\"`"+`
+	Type    uint8
+	Modules abs.ListLike[string] `+"`json:\"modules,omitempty\"`"+`
+}
+
+// Constructor Function
+
+/*
+MakeOptions returns a new structure containing the specified values.
+*/
+func MakeOptions(
+	name string,
+	type_ uint8,
+	modules abs.ListLike[string],
+) Options {
+	return Options{
+		Name:    name,
+		Type:    type_,
+		Modules: modules,
+	}
+}
+`, structures.GetValue("options"))
+
+	// The generated structure replaces the one declared by the model.
+	var source = generator.GenerateModelPackage(model)
+	ass.False(t, sts.Contains(source, "type Options struct"))
+	ass.False(t, sts.Contains(source, "// Type Definitions"))
+	compileModel(t, structuresSource, `package example
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	tes "testing"
+)
+
+func TestOptions(t *tes.T) {
+	var options = MakeOptions("x", 1, col.List[string]())
+	if options.Name != "x" {
+		t.Error("The name field should be initialized.")
+	}
+}
+`)
+}
+
+func compileModel(t *tes.T, source string, testSource string) {
+	// Generate the package into its own module that requires the same modules
+	// as this one.
+	var directory = t.TempDir()
+	var bytes, err = osx.ReadFile("../go.mod")
+	ass.Nil(t, err)
+	var modules = sts.Replace(
+		string(bytes),
+		"module github.com/craterdog/go-model-framework/v4",
+		"module example.com/example",
+		1,
+	)
+	bytes, err = osx.ReadFile("../go.sum")
+	ass.Nil(t, err)
+	var files = map[string]string{
+		"go.mod":          modules,
+		"go.sum":          string(bytes),
+		"Package_test.go": testSource,
+	}
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var generator = gen.Classes().Make()
+	files["Package.go"] = generator.GenerateModelPackage(model)
+	var constants = generator.GenerateModelConstants(model)
+	if len(constants) > 0 {
+		files["constants.go"] = constants
+	}
+	var classes = generator.GenerateModelClasses(model).GetIterator()
+	for classes.HasNext() {
+		var association = classes.GetNext()
+		files[association.GetKey()+".go"] = association.GetValue()
+	}
+	var structures = generator.GenerateModelStructures(model).GetIterator()
+	for structures.HasNext() {
+		var association = structures.GetNext()
+		files[association.GetKey()+".go"] = association.GetValue()
+	}
+	for filename, content := range files {
+		err = osx.WriteFile(fil.Join(directory, filename), []byte(content), 0644)
+		ass.Nil(t, err)
+	}
+
+	// Compile the package and run its tests.
+	var command = exe.Command("go", "test", "-count=1", ".")
+	command.Dir = directory
+	var output, _ = command.CombinedOutput()
+	ass.True(t, sts.HasPrefix(string(output), "ok"), string(output))
+}
+
+const variadicSource = `/*
+ Notice
+*/
+
+/*
+Package "example" is a class model.
+*/
+package example
+
+// Class Definitions
+
+/*
+BetaClassLike is a class interface.
+*/
+type BetaClassLike[V any] interface {
+	// Constructor Methods
+	Make(
+		name string,
+		values ...V,
+	) BetaLike[V]
+}
+
+// Instance Definitions
+
+/*
+BetaLike is an instance interface.
+*/
+type BetaLike[V any] interface {
+	// Public Methods
+	GetClass() BetaClassLike[V]
+
+	// Attribute Methods
+	GetName() string
+	GetValues() []V
+}
+`
+
+func TestVariadicConstructors(t *tes.T) {
+	compileModel(t, variadicSource, `package example
+
+import (
+	tes "testing"
+)
+
+func TestMake(t *tes.T) {
+	var beta = Beta[int]().Make("x")
+	if len(beta.GetValues()) != 0 {
+		t.Error("The variadic attribute should be empty.")
+	}
+	beta = Beta[int]().Make("x", 1, 2)
+	if len(beta.GetValues()) != 2 {
+		t.Error("The variadic attribute should contain two values.")
+	}
+}
+`)
+}
+
+const prefixedSource = `/*
+ Notice
+*/
+
+/*
+Package "example" is a class model.
+*/
+package example
+
+import (
+	big "math/big"
+)
+
+// Class Definitions
+
+/*
+BetaClassLike is a class interface.
+*/
+type BetaClassLike interface {
+	// Constructor Methods
+	Make() BetaLike
+}
+
+// Instance Definitions
+
+/*
+BetaLike is an instance interface.
+*/
+type BetaLike interface {
+	// Public Methods
+	GetClass() BetaClassLike
+
+	// Aspect Methods
+	Sequential[*big.Int]
+}
+
+// Aspect Definitions
+
+/*
+Sequential[V any] is an aspect interface.
+*/
+type Sequential[V any] interface {
+	GetValue() V
+	AsArray() []V
+}
+`
+
+func TestPrefixedBindings(t *tes.T) {
+	// A generic type keeps its own prefixes followed by those of its concrete
+	// type.
+	compileModel(t, prefixedSource, "package example\n")
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(prefixedSource)
+	var generator = gen.Classes().Make()
+	var beta = generator.GenerateModelClasses(model).GetValue("beta")
+	ass.True(t, sts.Contains(beta, "AsArray() []*big.Int {"))
+}
+
+const embeddedSource = `/*
+ Notice
+*/
+
+/*
+Package "example" is a class model.
+*/
+package example
+
+// Class Definitions
+
+/*
+BetaClassLike is a class interface.
+*/
+type BetaClassLike interface {
+	// Constructor Methods
+	Make() BetaLike
+}
+
+// Instance Definitions
+
+/*
+BetaLike is an instance interface.
+*/
+type BetaLike interface {
+	// Public Methods
+	GetClass() BetaClassLike
+
+	// Aspect Methods
+	Indexed[string]
+	Sized
+}
+
+// Aspect Definitions
+
+/*
+Indexed[V any] is an aspect interface.
+*/
+type Indexed[V any] interface {
+	Sized
+	Sequential[V]
+	GetValue(
+		index int,
+	) V
+}
+
+/*
+Sequential[V any] is an aspect interface.
+*/
+type Sequential[V any] interface {
+	Sized
+	AsArray() []V
+	GetSize() int
+}
+
+/*
+Sized is an aspect interface.
+*/
+type Sized interface {
+	GetSize() int
+}
+`
+
+func TestEmbeddedAspects(t *tes.T) {
+	// Each method of an aspect that is embedded more than once is generated once.
+	compileModel(t, embeddedSource, `package example
+
+import (
+	tes "testing"
+)
+
+func TestMake(t *tes.T) {
+	var beta = Beta().Make()
+	if beta.GetSize() != 0 {
+		t.Error("The size should be zero.")
+	}
+}
+`)
+
+	// Aspects that embed each other cannot be generated.
+	var source = sts.Replace(
+		embeddedSource,
+		"interface {\n\tGetSize() int\n}",
+		"interface {\n\tIndexed[int]\n\tGetSize() int\n}",
+		1,
+	)
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	ass.PanicsWithValue(
+		t,
+		"An aspect may not embed itself: Indexed -> Sized -> Indexed",
+		func() { validator.ValidateModel(model) },
+	)
+	var generator = gen.Classes().Make()
+	ass.NotPanics(t, func() { generator.GenerateModelClasses(model) })
+}
+
+func TestModuleNames(t *tes.T) {
+	// A standard library module is named by the last element of its path.
+	var source = sts.Replace(
+		prefixedSource,
+		"\tbig \"math/big\"\n",
+		"\t\"math/big\"\n",
+		1,
+	)
+	source = sts.Replace(source, "\tAsArray() []V\n", "", 1)
+	compileModel(t, source, "package example\n")
+
+	// Any other module must be given an alias unless it is in the workspace.
+	source = sts.Replace(
+		source,
+		"\t\"math/big\"\n",
+		"\t\"math/big\"\n\t\"github.com/craterdog/go-collection-framework/v4\"\n",
+		1,
+	)
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var generator = gen.Classes().Make()
+	ass.PanicsWithValue(
+		t,
+		"The following module must be given an alias since its package name is unknown: github.com/craterdog/go-collection-framework/v4",
+		func() { generator.GenerateModelClasses(model) },
+	)
+	var workspace = parser.ParseDirectory("..")
+	source = sts.Replace(
+		source,
+		"github.com/craterdog/go-collection-framework/v4",
+		"github.com/craterdog/go-model-framework/v4/ast",
+		1,
+	)
+	source = sts.Replace(source, "Sequential[*big.Int]", "Sequential[ast.ModelLike]", 1)
+	model = parser.ParseSource(source)
+	generator = gen.Classes().MakeWithWorkspace(workspace)
+	var classes = generator.GenerateModelClasses(model)
+	var class = classes.GetValue("beta")
+	ass.True(t, sts.Contains(class, "\t\"github.com/craterdog/go-model-framework/v4/ast\"\n"))
+	ass.True(t, sts.Contains(class, "ast.ModelLike"))
+}
+
+const importedSource = `/*
+ Notice
+*/
+
+/*
+Package "example" is a class model.
+*/
+package example
+
+import (
+	fmt "fmt"
+	io "io"
+)
+
+// Class Definitions
+
+/*
+BetaClassLike is a class interface.
+*/
+type BetaClassLike interface {
+	// Constructor Methods
+	Make() BetaLike
+}
+
+// Instance Definitions
+
+/*
+BetaLike is an instance interface.
+*/
+type BetaLike interface {
+	// Public Methods
+	GetClass() BetaClassLike
+
+	// Aspect Methods
+	fmt.Stringer
+	io.Writer
+}
+`
+
+const otherSource = `/*
+ Notice
+*/
+
+/*
+Package "other" is a class model.
+*/
+package other
+
+import (
+	io "io"
+)
+
+// Type Definitions
+
+/*
+Kind is a constrained type.
+*/
+type Kind uint8
+
+// Class Definitions
+
+/*
+GammaClassLike is a class interface.
+*/
+type GammaClassLike interface {
+	// Constructor Methods
+	Make() GammaLike
+}
+
+// Instance Definitions
+
+/*
+GammaLike is an instance interface.
+*/
+type GammaLike interface {
+	// Public Methods
+	GetClass() GammaClassLike
+}
+
+// Aspect Definitions
+
+/*
+Kinded[V any] is an aspect interface.
+*/
+type Kinded[V any] interface {
+	GetKind() Kind
+	GetReader() io.Reader
+	GetValue() V
+}
+`
+
+func TestImportedAspects(t *tes.T) {
+	// The methods of an aspect imported from the workspace are flattened
+	// using the types of the importing model.
+	var parser = gra.Parser().Make()
+	var source = sts.Replace(
+		importedSource,
+		"\tio \"io\"\n",
+		"\tstd \"io\"\n\toth \"example.com/m/other\"\n",
+		1,
+	)
+	source = sts.Replace(source, "\tio.Writer\n", "\toth.Kinded[string]\n", 1)
+	var model = parser.ParseSource(source)
+	var models = col.Catalog[string, ast.ModelLike]()
+	models.SetValue("example.com/m/other", parser.ParseSource(otherSource))
+	var workspace = gra.Workspace().Make("example.com/m", models)
+	var generator = gen.Classes().MakeWithWorkspace(workspace)
+	var beta = generator.GenerateModelClasses(model).GetValue("beta")
+	ass.True(t, sts.Contains(beta, "GetKind() oth.Kind {"))
+	ass.True(t, sts.Contains(beta, "GetReader() std.Reader {"))
+	ass.True(t, sts.Contains(beta, "GetValue() string {"))
+
+	// The methods of an aspect imported from outside of the workspace are
+	// left to be implemented by hand.
+	ass.True(t, sts.Contains(beta, "// fmt.Stringer Methods\n\n//"))
+
+	// So are the methods of an aspect that uses a type whose module is not
+	// imported.
+	var unimported = sts.Replace(source, "\tstd \"io\"\n", "", 1)
+	model = parser.ParseSource(unimported)
+	beta = generator.GenerateModelClasses(model).GetValue("beta")
+	ass.True(t, sts.Contains(beta, "// oth.Kinded[string] Methods\n\n//"))
+	ass.False(t, sts.Contains(beta, "GetKind()"))
+
+	// And the methods of an aspect that cannot be found.
+	var unresolved = sts.Replace(
+		importedSource,
+		"\tio \"io\"\n",
+		"\tabs \"github.com/craterdog/go-collection-framework/v4/collection\"\n",
+		1,
+	)
+	unresolved = sts.Replace(unresolved, "BetaClassLike interface", "BetaClassLike[V any] interface", 1)
+	unresolved = sts.Replace(unresolved, "BetaLike interface", "BetaLike[V any] interface", 1)
+	unresolved = sts.Replace(unresolved, "Make() BetaLike", "Make() BetaLike[V]", 1)
+	unresolved = sts.Replace(unresolved, "GetClass() BetaClassLike", "GetClass() BetaClassLike[V]", 1)
+	unresolved = sts.Replace(unresolved, "\tio.Writer\n", "\tabs.Sequential[V]\n", 1)
+	model = parser.ParseSource(unresolved)
+	beta = gen.Classes().Make().GenerateModelClasses(model).GetValue("beta")
+	ass.True(t, sts.Contains(beta, "// abs.Sequential[V] Methods\n\n//"))
+}
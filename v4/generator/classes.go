@@ -18,6 +18,8 @@ import (
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	uti "github.com/craterdog/go-missing-utilities/v2"
 	ast "github.com/craterdog/go-model-framework/v4/ast"
+	gra "github.com/craterdog/go-model-framework/v4/grammar"
+	gof "go/format"
 	sts "strings"
 )
 
@@ -38,6 +40,19 @@ func (c *classesClass_) Make() ClassesLike {
 	return instance
 }
 
+func (c *classesClass_) MakeWithWorkspace(
+	workspace gra.WorkspaceLike,
+) ClassesLike {
+	if uti.IsUndefined(workspace) {
+		panic("The \"workspace\" attribute is required by this class.")
+	}
+	var instance = &classes_{
+		// Initialize the instance attributes.
+		workspace_: workspace,
+	}
+	return instance
+}
+
 // INSTANCE INTERFACE
 
 // Public Methods
@@ -50,6 +65,8 @@ func (v *classes_) GenerateModelClasses(
 	model ast.ModelLike,
 ) abs.CatalogLike[string, string] {
 	var result_ = col.Catalog[string, string]()
+	v.symbols_ = gra.SymbolTable().Make(model)
+	v.analyzeModelModules(model)
 	var interfaceDefinitions = model.GetInterfaceDefinitions()
 	var classSection = interfaceDefinitions.GetClassSection()
 	var classDefinitions = classSection.GetClassDefinitions().GetIterator()
@@ -69,6 +86,89 @@ func (v *classes_) GenerateModelClasses(
 	return result_
 }
 
+func (v *classes_) GenerateModelConstants(
+	model ast.ModelLike,
+) string {
+	var result_ string
+	var primitiveDefinitions = model.GetPrimitiveDefinitions()
+	var constantSection = primitiveDefinitions.GetOptionalConstantSection()
+	if uti.IsDefined(constantSection) {
+		v.symbols_ = gra.SymbolTable().Make(model)
+		v.usesDotImports_ = false // This is set while the constants are generated.
+		result_ = v.generateConstants(model, constantSection)
+	}
+	return result_
+}
+
+func (v *classes_) GenerateModelPackage(
+	model ast.ModelLike,
+) string {
+	var result_ string
+
+	// The structures and constants of the model are declared by their generated
+	// files.
+	var primitiveDefinitions = model.GetPrimitiveDefinitions()
+	primitiveDefinitions = ast.PrimitiveDefinitions().Make(
+		v.generatePackageTypes(primitiveDefinitions.GetOptionalTypeSection()),
+		primitiveDefinitions.GetOptionalFunctionalSection(),
+		nil,
+	)
+
+	// Format the package without its imports to find the modules it still uses.
+	var moduleDefinition = model.GetModuleDefinition()
+	var notice = moduleDefinition.GetNotice()
+	var header = moduleDefinition.GetHeader()
+	var interfaceDefinitions = model.GetInterfaceDefinitions()
+	var formatter = gra.Formatter().Make()
+	model = ast.Model().Make(
+		ast.ModuleDefinition().Make(notice, header, nil),
+		primitiveDefinitions,
+		interfaceDefinitions,
+	)
+	result_ = formatter.FormatModel(model)
+
+	// Format the package again with only the modules that it uses.
+	var imports = v.generatePackageImports(
+		moduleDefinition.GetOptionalImports(),
+		result_,
+	)
+	model = ast.Model().Make(
+		ast.ModuleDefinition().Make(notice, header, imports),
+		primitiveDefinitions,
+		interfaceDefinitions,
+	)
+	result_ = formatter.FormatModel(model)
+	return result_
+}
+
+func (v *classes_) GenerateModelStructures(
+	model ast.ModelLike,
+) abs.CatalogLike[string, string] {
+	var result_ = col.Catalog[string, string]()
+	var primitiveDefinitions = model.GetPrimitiveDefinitions()
+	var typeSection = primitiveDefinitions.GetOptionalTypeSection()
+	if uti.IsDefined(typeSection) {
+		v.symbols_ = gra.SymbolTable().Make(model)
+		var typeDefinitions = typeSection.GetTypeDefinitions().GetIterator()
+		for typeDefinitions.HasNext() {
+			var typeDefinition = typeDefinitions.GetNext()
+			var underlying = typeDefinition.GetUnderlying()
+			var structure, isStructure = underlying.GetAny().(ast.StructureLike)
+			if isStructure {
+				var declaration = typeDefinition.GetDeclaration()
+				var structureName = uti.MakeLowerCase(declaration.GetName())
+				var implementation = v.generateStructure(
+					model,
+					declaration,
+					structure,
+				)
+				result_.SetValue(structureName, implementation)
+			}
+		}
+	}
+	return result_
+}
+
 // Private Methods
 
 func (v *classes_) getClass() *classesClass_ {
@@ -96,6 +196,7 @@ func (v *classes_) analyzeClassDefinition(
 	classDefinition ast.ClassDefinitionLike,
 	instanceDefinition ast.InstanceDefinitionLike,
 ) {
+	v.usesDotImports_ = false // This is set while the class is generated.
 	v.analyzeClassGenerics(classDefinition)
 	v.analyzeClassStructure(instanceDefinition)
 	v.analyzeClassConstants(classDefinition)
@@ -133,6 +234,25 @@ func (v *classes_) analyzeClassStructure(
 	}
 }
 
+func (v *classes_) analyzeModelModules(
+	model ast.ModelLike,
+) {
+	v.modules_ = col.Catalog[string, string]()
+	var imports = model.GetModuleDefinition().GetOptionalImports()
+	if uti.IsDefined(imports) {
+		var modules = imports.GetModules().GetIterator()
+		for modules.HasNext() {
+			var module = modules.GetNext()
+			var moduleName = v.extractModuleName(module)
+			if moduleName == "_" || moduleName == "." {
+				// The types of this module are not qualified by a module name.
+				continue
+			}
+			v.modules_.SetValue(moduleName, sts.Trim(module.GetPath(), "\""))
+		}
+	}
+}
+
 func (v *classes_) analyzePrivateAttributes(
 	classDefinition ast.ClassDefinitionLike,
 ) {
@@ -150,6 +270,10 @@ func (v *classes_) analyzePrivateAttributes(
 				var parameter = parameters.GetNext()
 				var attributeName = sts.TrimSuffix(parameter.GetName(), "_")
 				var attributeType = v.extractType(parameter.GetAbstraction())
+				if parameter.IsVariadic() {
+					// A variadic parameter is passed as a slice.
+					attributeType = "[]" + attributeType
+				}
 				v.attributes_.SetValue(attributeName, attributeType)
 			}
 		}
@@ -254,6 +378,135 @@ func (v *classes_) extractConcreteMappings(
 	return mappings
 }
 
+func (v *classes_) extractConstraint(constraint ast.ConstraintLike) string {
+	var constraintType = constraint.GetName() + " "
+	constraintType += v.extractTerm(constraint.GetTerm())
+	var additionalTerms = constraint.GetAdditionalTerms().GetIterator()
+	for additionalTerms.HasNext() {
+		var additionalTerm = additionalTerms.GetNext().GetTerm()
+		constraintType += " | " + v.extractTerm(additionalTerm)
+	}
+	return constraintType
+}
+
+func (v *classes_) extractImportedAspect(
+	aspectType ast.AbstractionLike,
+) (
+	model ast.ModelLike,
+	aspectDefinition ast.AspectDefinitionLike,
+) {
+	// Find the model of the imported module in the workspace.
+	var modulePath = v.modules_.GetValue(aspectType.GetName())
+	if uti.IsUndefined(modulePath) || uti.IsUndefined(v.workspace_) {
+		return model, aspectDefinition
+	}
+	model = v.workspace_.GetModel(modulePath)
+	if uti.IsUndefined(model) {
+		return model, aspectDefinition
+	}
+
+	// Find the definition of the aspect in the model.
+	var aspectSection = model.GetInterfaceDefinitions().GetOptionalAspectSection()
+	if uti.IsDefined(aspectSection) {
+		var aspectName = aspectType.GetOptionalSuffix().GetName()
+		var aspectDefinitions = aspectSection.GetAspectDefinitions().GetIterator()
+		for aspectDefinitions.HasNext() {
+			var candidate = aspectDefinitions.GetNext()
+			if candidate.GetDeclaration().GetName() == aspectName {
+				aspectDefinition = candidate
+				break
+			}
+		}
+	}
+	return model, aspectDefinition
+}
+
+func (v *classes_) extractImportedMappings(
+	model ast.ModelLike,
+	declaration ast.DeclarationLike,
+	moduleName string,
+	mappings abs.CatalogLike[string, ast.AbstractionLike],
+) (
+	ok bool,
+) {
+	// Find the module path of each module imported by the model.
+	var modulePaths = col.Catalog[string, string]()
+	var imports = model.GetModuleDefinition().GetOptionalImports()
+	if uti.IsDefined(imports) {
+		var modules = imports.GetModules().GetIterator()
+		for modules.HasNext() {
+			var module = modules.GetNext()
+			var name = gra.Workspace().ModuleName(module, v.workspace_)
+			modulePaths.SetValue(name, sts.Trim(module.GetPath(), "\""))
+		}
+	}
+
+	// Qualify each type used by the aspect with the module that provides it.
+	var symbols = gra.SymbolTable().Make(model)
+	var references = gra.CrossReference().Make(model)
+	var typeNames = references.GetTypeNames().GetIterator()
+	for typeNames.HasNext() {
+		var typeName = typeNames.GetNext()
+		if uti.IsDefined(mappings.GetValue(typeName)) || gra.SymbolTable().IsPredeclared(typeName) {
+			continue
+		}
+		var usedByAspect bool
+		var usages = references.GetUsages(typeName).GetIterator()
+		for usages.HasNext() {
+			if usages.GetNext().GetOptionalDeclaration() == declaration {
+				usedByAspect = true
+				break
+			}
+		}
+		if !usedByAspect {
+			continue
+		}
+		var qualifier, name, isQualified = sts.Cut(typeName, ".")
+		if !isQualified {
+			if uti.IsDefined(symbols.LookupType(typeName)) {
+				// eg. ModelLike -> ast.ModelLike
+				var suffix = ast.Suffix().Make(typeName)
+				var prefixes = col.List[ast.PrefixLike]()
+				var concreteType = ast.Abstraction().Make(prefixes, moduleName, suffix, nil)
+				mappings.SetValue(typeName, concreteType)
+			}
+			continue
+		}
+		var modulePath = modulePaths.GetValue(qualifier)
+		var importingName string
+		var modules = v.modules_.GetIterator()
+		for modules.HasNext() {
+			var association = modules.GetNext()
+			if association.GetValue() == modulePath {
+				importingName = association.GetKey()
+				break
+			}
+		}
+		if uti.IsUndefined(modulePath) || uti.IsUndefined(importingName) {
+			// The module that provides this type is not imported.
+			return false
+		}
+		// eg. col.ListLike -> abs.ListLike
+		var suffix = ast.Suffix().Make(name)
+		var prefixes = col.List[ast.PrefixLike]()
+		var concreteType = ast.Abstraction().Make(prefixes, importingName, suffix, nil)
+		mappings.SetValue(typeName, concreteType)
+	}
+	return true
+}
+
+func (v *classes_) extractModuleName(module ast.ModuleLike) string {
+	var moduleName = gra.Workspace().ModuleName(module, v.workspace_)
+	if uti.IsUndefined(moduleName) {
+		var message = fmt.Sprintf(
+			"The following module must be given an alias since its package name is unknown: %v",
+			sts.Trim(module.GetPath(), "\""),
+		)
+		panic(message)
+	}
+	return moduleName
+}
+
 func (v *classes_) extractNotice(model ast.ModelLike) string {
 	var definition = model.GetModuleDefinition()
 	var notice = definition.GetNotice().GetComment()
@@ -267,22 +520,190 @@ func (v *classes_) extractPackageName(model ast.ModelLike) string {
 	return packageName
 }
 
+func (v *classes_) extractExpression(expression ast.ExpressionLike) string {
+	var value = v.extractOperand(expression.GetOperand())
+	var operations = expression.GetOperations().GetIterator()
+	for operations.HasNext() {
+		var operation = operations.GetNext()
+		value += " " + operation.GetOperator().GetAny().(string) + " "
+		value += v.extractOperand(operation.GetOperand())
+	}
+	return value
+}
+
+func (v *classes_) extractFunction(function ast.FunctionLike) string {
+	var functionType = "func("
+	var argument = function.GetOptionalArgument()
+	if uti.IsDefined(argument) {
+		functionType += v.extractType(argument.GetAbstraction())
+	}
+	var additionalArguments = function.GetAdditionalArguments().GetIterator()
+	for additionalArguments.HasNext() {
+		var additionalArgument = additionalArguments.GetNext().GetArgument()
+		functionType += ", " + v.extractType(additionalArgument.GetAbstraction())
+	}
+	functionType += ")"
+	var result = function.GetOptionalResult()
+	if uti.IsDefined(result) {
+		switch actual := result.GetAny().(type) {
+		case ast.AbstractionLike:
+			functionType += " " + v.extractType(actual)
+		case ast.TupleLike:
+			functionType += " " + v.extractTuple(actual)
+		case ast.ParameterizedLike:
+			// Inline function types are formatted on a single line.
+			var outputs []string
+			for _, output := range v.extractOutputs(actual) {
+				outputs = append(
+					outputs,
+					output.GetName()+" "+v.extractType(output.GetAbstraction()),
+				)
+			}
+			functionType += " (" + sts.Join(outputs, ", ") + ")"
+		}
+	}
+	return functionType
+}
+
+func (v *classes_) extractTerm(term ast.TermLike) string {
+	var termType = v.extractType(term.GetAbstraction())
+	if term.IsApproximate() {
+		termType = "~" + termType
+	}
+	return termType
+}
+
+func (v *classes_) extractOperand(operand ast.OperandLike) string {
+	var value string
+	switch actual := operand.GetAny().(type) {
+	case ast.ReferenceLike:
+		value = v.extractReference(actual)
+	case ast.UnaryLike:
+		value = actual.GetOperator() + v.extractOperand(actual.GetOperand())
+	case ast.PrecedenceLike:
+		value = "(" + v.extractExpression(actual.GetExpression()) + ")"
+	case string:
+		value = actual
+	}
+	return value
+}
+
+func (v *classes_) extractOutputs(
+	parameterized ast.ParameterizedLike,
+) []ast.OutputLike {
+	var outputs = []ast.OutputLike{parameterized.GetOutput()}
+	var additionalOutputs = parameterized.GetAdditionalOutputs().GetIterator()
+	for additionalOutputs.HasNext() {
+		outputs = append(outputs, additionalOutputs.GetNext().GetOutput())
+	}
+	return outputs
+}
+
+func (v *classes_) extractReference(reference ast.ReferenceLike) string {
+	var value = reference.GetName()
+	var suffix = reference.GetOptionalSuffix()
+	if uti.IsDefined(suffix) {
+		value += "." + suffix.GetName()
+	}
+	var invocation = reference.GetOptionalInvocation()
+	if uti.IsDefined(invocation) {
+		// eg. errors.New("not found")
+		value += "("
+		var expression = invocation.GetOptionalExpression()
+		if uti.IsDefined(expression) {
+			value += v.extractExpression(expression)
+		}
+		var additionalExpressions = invocation.GetAdditionalExpressions().GetIterator()
+		for additionalExpressions.HasNext() {
+			expression = additionalExpressions.GetNext().GetExpression()
+			value += ", " + v.extractExpression(expression)
+		}
+		value += ")"
+	}
+	return value
+}
+
+func (v *classes_) extractResultName(index int, count int) string {
+	// A single unnamed result is simply called "result".
+	var resultName = "result"
+	if count > 1 {
+		resultName += fmt.Sprintf("%d", index+1)
+	}
+	return resultName
+}
+
+func (v *classes_) extractResultTypes(result ast.ResultLike) []string {
+	// Only unnamed results must be declared by the method implementation.
+	var resultTypes []string
+	if uti.IsDefined(result) {
+		switch actual := result.GetAny().(type) {
+		case ast.AbstractionLike:
+			resultTypes = append(resultTypes, v.extractType(actual))
+		case ast.TupleLike:
+			var argument = actual.GetArgument()
+			resultTypes = append(resultTypes, v.extractType(argument.GetAbstraction()))
+			var additionalArguments = actual.GetAdditionalArguments().GetIterator()
+			for additionalArguments.HasNext() {
+				argument = additionalArguments.GetNext().GetArgument()
+				resultTypes = append(resultTypes, v.extractType(argument.GetAbstraction()))
+			}
+		}
+	}
+	return resultTypes
+}
+
+func (v *classes_) extractTuple(tuple ast.TupleLike) string {
+	var tupleType = "(" + v.extractType(tuple.GetArgument().GetAbstraction())
+	var additionalArguments = tuple.GetAdditionalArguments().GetIterator()
+	for additionalArguments.HasNext() {
+		var additionalArgument = additionalArguments.GetNext().GetArgument()
+		tupleType += ", " + v.extractType(additionalArgument.GetAbstraction())
+	}
+	tupleType += ")"
+	return tupleType
+}
+
 func (v *classes_) extractType(abstraction ast.AbstractionLike) string {
 	var abstractType string
-	var prefix = abstraction.GetOptionalPrefix()
-	if uti.IsDefined(prefix) {
+	var prefixes = abstraction.GetPrefixes().GetIterator()
+	for prefixes.HasNext() {
+		var prefix = prefixes.GetNext()
 		switch actual := prefix.GetAny().(type) {
 		case ast.ArrayLike:
-			abstractType = "[]"
+			var size = actual.GetOptionalSize()
+			if uti.IsDefined(size) {
+				abstractType += "[" + size.GetAny().(string) + "]"
+			} else {
+				abstractType += "[]"
+			}
 		case ast.MapLike:
-			abstractType = "map[" + actual.GetName() + "]"
+			abstractType += "map[" + actual.GetName() + "]"
 		case ast.ChannelLike:
-			abstractType = "chan "
+			switch actual.GetDirection() {
+			case ast.Receive:
+				abstractType += "<-chan "
+			case ast.Send:
+				abstractType += "chan<- "
+			default:
+				abstractType += "chan "
+			}
+		case ast.PointerLike:
+			abstractType += "*"
 		}
 	}
+	var function = abstraction.GetOptionalFunction()
+	if uti.IsDefined(function) {
+		abstractType += v.extractFunction(function)
+		return abstractType
+	}
 	var name = abstraction.GetName()
 	abstractType += name
 	var suffix = abstraction.GetOptionalSuffix()
+	if uti.IsUndefined(suffix) && !gra.SymbolTable().IsPredeclared(name) &&
+		v.symbols_.LookupSymbols(name).IsEmpty() {
+		// This type must be provided by a dot import.
+		v.usesDotImports_ = true
+	}
 	if uti.IsDefined(suffix) {
 		abstractType += "." + suffix.GetName()
 	}
@@ -309,14 +730,13 @@ func (v *classes_) generateAccessFunction() (
 }
 
 func (v *classes_) generateArguments(
-	classDefinition ast.ClassDefinitionLike,
+	declaration ast.DeclarationLike,
 ) (
 	arguments string,
 ) {
 	if v.isGeneric_ {
 		arguments = "["
-		var classDeclaration = classDefinition.GetDeclaration()
-		var optionalConstraints = classDeclaration.GetOptionalConstraints()
+		var optionalConstraints = declaration.GetOptionalConstraints()
 		var constraint = optionalConstraints.GetConstraint()
 		var argument = constraint.GetName()
 		arguments += argument
@@ -337,25 +757,11 @@ func (v *classes_) generateAspectInterface(
 ) (
 	implementation string,
 ) {
-	var methods string
-	if uti.IsDefined(aspectSection) {
-		var aspectDefinitions = aspectSection.GetAspectDefinitions().GetIterator()
-		for aspectDefinitions.HasNext() {
-			var aspectDefinition = aspectDefinitions.GetNext()
-			var declaration = aspectDefinition.GetDeclaration()
-			var constraints = declaration.GetOptionalConstraints()
-			var arguments = aspectType.GetOptionalArguments()
-			if uti.IsUndefined(aspectType.GetOptionalSuffix()) &&
-				declaration.GetName() == aspectType.GetName() {
-				var mappings = v.extractConcreteMappings(constraints, arguments)
-				methods = v.generateAspectMethods(
-					aspectType,
-					aspectDefinition,
-					mappings,
-				)
-			}
-		}
+	if v.aspects_.ContainsValue(v.extractType(aspectType)) {
+		// The methods of this aspect were flattened into a previous aspect.
+		return implementation
 	}
+	var methods = v.generateAspectMethods(aspectType, aspectSection)
 	implementation = v.getClass().aspectInterface_
 	implementation = uti.ReplaceAll(
 		implementation,
@@ -376,6 +782,9 @@ func (v *classes_) generateAspectInterfaces(
 ) (
 	implementation string,
 ) {
+	// Each aspect and method is generated only once per class.
+	v.aspects_ = col.Set[string]()
+	v.methods_ = col.Set[string]()
 	if uti.IsDefined(aspectSubsection) {
 		var aspectInterfaces = aspectSubsection.GetAspectInterfaces().GetIterator()
 		for aspectInterfaces.HasNext() {
@@ -405,26 +814,117 @@ func (v *classes_) generateAspectMethod(
 	}
 	var parameters = v.generateParameters(methodParameters)
 	var resultType = v.generateResult(methodResult)
+	var note = v.generateNote(method.GetOptionalNote())
 	implementation = v.getClass().instanceMethod_
 	if uti.IsDefined(resultType) {
 		implementation = v.getClass().instanceFunction_
 		implementation = uti.ReplaceAll(implementation, "resultType", resultType)
 	}
+	implementation = uti.ReplaceAll(implementation, "note", note)
 	implementation = uti.ReplaceAll(implementation, "methodName", methodName)
 	implementation = uti.ReplaceAll(implementation, "parameters", parameters)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"resultDeclarations",
+		v.generateResultDeclarations(methodResult),
+	)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"resultValues",
+		v.generateResultValues(methodResult),
+	)
 	return implementation
 }
 
 func (v *classes_) generateAspectMethods(
+	aspectType ast.AbstractionLike,
+	aspectSection ast.AspectSectionLike,
+) (
+	implementation string,
+) {
+	// An aspect that is embedded more than once is only flattened once.
+	var aspectName = v.extractType(aspectType)
+	if v.aspects_.ContainsValue(aspectName) {
+		return implementation
+	}
+	v.aspects_.AddValue(aspectName)
+
+	// The types used by an imported aspect are qualified by its module.  The
+	// methods of an imported aspect that cannot be resolved using the workspace
+	// must be implemented by hand.
+	if uti.IsDefined(aspectType.GetOptionalSuffix()) {
+		var model, aspectDefinition = v.extractImportedAspect(aspectType)
+		if uti.IsUndefined(aspectDefinition) {
+			return implementation
+		}
+		var declaration = aspectDefinition.GetDeclaration()
+		var constraints = declaration.GetOptionalConstraints()
+		var arguments = aspectType.GetOptionalArguments()
+		var mappings = v.extractConcreteMappings(constraints, arguments)
+		var moduleName = aspectType.GetName()
+		if !v.extractImportedMappings(model, declaration, moduleName, mappings) {
+			return implementation
+		}
+		implementation = v.generateAspectDefinitionMethods(
+			aspectType,
+			aspectDefinition,
+			mappings,
+			aspectSection,
+		)
+		return implementation
+	}
+	if uti.IsUndefined(aspectSection) {
+		return implementation
+	}
+	var aspectDefinitions = aspectSection.GetAspectDefinitions().GetIterator()
+	for aspectDefinitions.HasNext() {
+		var aspectDefinition = aspectDefinitions.GetNext()
+		var declaration = aspectDefinition.GetDeclaration()
+		if declaration.GetName() != aspectType.GetName() {
+			continue
+		}
+		var constraints = declaration.GetOptionalConstraints()
+		var arguments = aspectType.GetOptionalArguments()
+		var mappings = v.extractConcreteMappings(constraints, arguments)
+		implementation += v.generateAspectDefinitionMethods(
+			aspectType,
+			aspectDefinition,
+			mappings,
+			aspectSection,
+		)
+	}
+	return implementation
+}
+
+func (v *classes_) generateAspectDefinitionMethods(
 	aspectType ast.AbstractionLike,
 	aspectDefinition ast.AspectDefinitionLike,
 	mappings abs.CatalogLike[string, ast.AbstractionLike],
+	aspectSection ast.AspectSectionLike,
 ) (
 	implementation string,
 ) {
+	// Flatten the methods of any embedded aspects into this aspect.
+	var aspectInterfaces = aspectDefinition.GetAspectInterfaces().GetIterator()
+	for aspectInterfaces.HasNext() {
+		var embeddedType = aspectInterfaces.GetNext().GetAbstraction()
+		if mappings.GetSize() > 0 {
+			// eg. Sequential[V] -> Sequential[string]
+			embeddedType = v.replaceAbstractionType(embeddedType, mappings)
+		}
+		implementation += v.generateAspectMethods(embeddedType, aspectSection)
+	}
+
+	// Generate the methods defined by this aspect.
 	var aspectMethods = aspectDefinition.GetAspectMethods().GetIterator()
 	for aspectMethods.HasNext() {
 		var aspectMethod = aspectMethods.GetNext()
+		var methodName = aspectMethod.GetMethod().GetName()
+		if v.methods_.ContainsValue(methodName) {
+			// Another aspect already declares this method.
+			continue
+		}
+		v.methods_.AddValue(methodName)
 		implementation += v.generateAspectMethod(
 			aspectType,
 			aspectMethod,
@@ -439,8 +939,8 @@ func (v *classes_) generateAttributeCheck(parameter ast.ParameterLike) (
 ) {
 	var parameterName = parameter.GetName()
 	var attributeName = sts.TrimSuffix(parameterName, "_")
-	// Ignore optional attributes.
-	if !sts.HasPrefix(attributeName, "optional") {
+	// Ignore optional attributes and variadic attributes which may be empty.
+	if !sts.HasPrefix(attributeName, "optional") && !parameter.IsVariadic() {
 		var template = v.getClass().attributeCheck_
 		template = uti.ReplaceAll(template, "attributeName", attributeName)
 		implementation += template
@@ -665,8 +1165,9 @@ func (v *classes_) generateClass(
 	var constraints string
 	var arguments string
 	if v.isGeneric_ {
-		constraints = v.generateConstraints(classDefinition)
-		arguments = v.generateArguments(classDefinition)
+		var declaration = classDefinition.GetDeclaration()
+		constraints = v.generateConstraints(declaration)
+		arguments = v.generateArguments(declaration)
 	}
 	implementation = uti.ReplaceAll(
 		implementation,
@@ -719,6 +1220,52 @@ func (v *classes_) generateClassStructure() (
 	return implementation
 }
 
+func (v *classes_) generateConstantDefinition(
+	constantDefinition ast.ConstantDefinitionLike,
+) (
+	implementation string,
+) {
+	var constantType string
+	var abstraction = constantDefinition.GetOptionalAbstraction()
+	if uti.IsDefined(abstraction) {
+		constantType = " " + v.extractType(abstraction)
+	}
+	var initializer = constantDefinition.GetInitializer()
+	var constantValue = v.extractExpression(initializer.GetExpression())
+	implementation = v.getClass().constantDefinition_
+	implementation = uti.ReplaceAll(
+		implementation,
+		"comment",
+		constantDefinition.GetComment(),
+	)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"qualifier",
+		constantDefinition.GetQualifier().GetAny().(string),
+	)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"constantName",
+		constantDefinition.GetName(),
+	)
+	implementation = uti.ReplaceAll(implementation, "constantType", constantType)
+	implementation = uti.ReplaceAll(implementation, "constantValue", constantValue)
+	return implementation
+}
+
+func (v *classes_) generateConstantDefinitions(
+	constantSection ast.ConstantSectionLike,
+) (
+	implementation string,
+) {
+	var constantDefinitions = constantSection.GetConstantDefinitions().GetIterator()
+	for constantDefinitions.HasNext() {
+		var constantDefinition = constantDefinitions.GetNext()
+		implementation += v.generateConstantDefinition(constantDefinition)
+	}
+	return implementation
+}
+
 func (v *classes_) generateConstantDeclarations() (
 	implementation string,
 ) {
@@ -754,7 +1301,9 @@ func (v *classes_) generateConstantMethod(constantMethod ast.ConstantMethodLike)
 ) {
 	var methodName = constantMethod.GetName()
 	var resultType = v.extractType(constantMethod.GetAbstraction())
+	var note = v.generateNote(constantMethod.GetOptionalNote())
 	implementation = v.getClass().constantMethod_
+	implementation = uti.ReplaceAll(implementation, "note", note)
 	implementation = uti.ReplaceAll(implementation, "methodName", methodName)
 	implementation = uti.ReplaceAll(implementation, "resultType", resultType)
 	return implementation
@@ -780,25 +1329,58 @@ func (v *classes_) generateConstantMethods(
 	return implementation
 }
 
+func (v *classes_) generateConstants(
+	model ast.ModelLike,
+	constantSection ast.ConstantSectionLike,
+) (
+	implementation string,
+) {
+	// Start with the constants template.
+	implementation = v.getClass().constantsTemplate_
+	var notice = v.extractNotice(model)
+	implementation = uti.ReplaceAll(implementation, "notice", notice)
+
+	// Add in the package declaration.
+	var packageDeclaration = v.generatePackageDeclaration(model)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"packageDeclaration",
+		packageDeclaration,
+	)
+
+	// Add in the constant definitions.
+	var constantDefinitions = v.generateConstantDefinitions(constantSection)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"constantDefinitions",
+		constantDefinitions,
+	)
+
+	// Insert any imported modules (this must be done last).
+	var moduleImports = v.generateImports(model, implementation)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"moduleImports",
+		moduleImports,
+	)
+
+	return implementation
+}
+
 func (v *classes_) generateConstraints(
-	classDefinition ast.ClassDefinitionLike,
+	declaration ast.DeclarationLike,
 ) (
 	constraints string,
 ) {
 	if v.isGeneric_ {
 		constraints = "["
-		var classDeclaration = classDefinition.GetDeclaration()
-		var optionalConstraints = classDeclaration.GetOptionalConstraints()
+		var optionalConstraints = declaration.GetOptionalConstraints()
 		var constraint = optionalConstraints.GetConstraint()
-		var constraintName = constraint.GetName()
-		var constraintType = v.extractType(constraint.GetAbstraction())
-		constraints += constraintName + " " + constraintType
+		constraints += v.extractConstraint(constraint)
 		var additionalConstraints = optionalConstraints.GetAdditionalConstraints().GetIterator()
 		for additionalConstraints.HasNext() {
 			constraint = additionalConstraints.GetNext().GetConstraint()
-			constraintName = constraint.GetName()
-			constraintType = v.extractType(constraint.GetAbstraction())
-			constraints += ", " + constraintName + " " + constraintType
+			constraints += ", " + v.extractConstraint(constraint)
 		}
 		constraints += "]"
 	}
@@ -815,7 +1397,13 @@ func (v *classes_) generateConstructorMethod(
 	var parameters = v.generateParameters(constructorParameters)
 	var resultType = v.extractType(constructorMethod.GetAbstraction())
 	var instanceInstantiation = v.generateInstanceInstantiation(constructorMethod)
+	var note = v.generateNote(constructorMethod.GetOptionalNote())
 	implementation = v.getClass().constructorMethod_
+	implementation = uti.ReplaceAll(
+		implementation,
+		"note",
+		note,
+	)
 	implementation = uti.ReplaceAll(
 		implementation,
 		"methodName",
@@ -857,15 +1445,84 @@ func (v *classes_) generateConstructorMethods(
 	return implementation
 }
 
+func (v *classes_) generateFieldDeclarations(
+	structure ast.StructureLike,
+) (
+	implementation string,
+) {
+	var fields = structure.GetFields().GetIterator()
+	for fields.HasNext() {
+		var field = fields.GetNext()
+		var fieldType = v.extractType(field.GetAbstraction())
+		var fieldTag = field.GetOptionalTag()
+		if uti.IsDefined(fieldTag) {
+			fieldTag = " " + fieldTag
+		}
+		var declaration = v.getClass().fieldDeclaration_
+		declaration = uti.ReplaceAll(declaration, "fieldName", field.GetName())
+		declaration = uti.ReplaceAll(declaration, "fieldType", fieldType)
+		declaration = uti.ReplaceAll(declaration, "fieldTag", fieldTag)
+		implementation += declaration
+	}
+	return implementation
+}
+
+func (v *classes_) generateFieldInitializations(
+	structure ast.StructureLike,
+) (
+	implementation string,
+) {
+	var fields = structure.GetFields().GetIterator()
+	for fields.HasNext() {
+		var field = fields.GetNext()
+		var initialization = v.getClass().fieldInitialization_
+		initialization = uti.ReplaceAll(initialization, "fieldName", field.GetName())
+		implementation += initialization
+	}
+	return implementation
+}
+
+func (v *classes_) generateFieldParameters(
+	structure ast.StructureLike,
+) (
+	implementation string,
+) {
+	var fields = structure.GetFields().GetIterator()
+	for fields.HasNext() {
+		var field = fields.GetNext()
+		var parameterType = v.extractType(field.GetAbstraction())
+		var template = v.getClass().methodParameter_
+		template = uti.ReplaceAll(template, "parameterName", field.GetName())
+		template = uti.ReplaceAll(template, "parameterType", parameterType)
+		implementation += template
+	}
+	if uti.IsDefined(implementation) {
+		implementation += "\n"
+	}
+	return implementation
+}
+
 func (v *classes_) generateFunctionMethod(functionMethod ast.FunctionMethodLike) (
 	implementation string,
 ) {
 	var methodName = functionMethod.GetName()
 	var parameters = v.generateParameters(functionMethod.GetParameters())
 	var resultType = v.generateResult(functionMethod.GetResult())
+	var note = v.generateNote(functionMethod.GetOptionalNote())
 	implementation = v.getClass().functionMethod_
+	implementation = uti.ReplaceAll(implementation, "note", note)
 	implementation = uti.ReplaceAll(implementation, "methodName", methodName)
 	implementation = uti.ReplaceAll(implementation, "parameters", parameters)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"resultDeclarations",
+		v.generateResultDeclarations(functionMethod.GetResult()),
+	)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"resultValues",
+		v.generateResultValues(functionMethod.GetResult()),
+	)
 	implementation = uti.ReplaceAll(implementation, "resultType", resultType)
 	return implementation
 }
@@ -896,7 +1553,9 @@ func (v *classes_) generateGetterMethod(getterMethod ast.GetterMethodLike) (
 	var methodName = getterMethod.GetName()
 	var attributeName = v.extractAttributeName(methodName)
 	var attributeType = v.extractType(getterMethod.GetAbstraction())
+	var note = v.generateNote(getterMethod.GetOptionalNote())
 	implementation = v.getClass().getterMethod_
+	implementation = uti.ReplaceAll(implementation, "note", note)
 	implementation = uti.ReplaceAll(implementation, "methodName", methodName)
 	implementation = uti.ReplaceAll(implementation, "attributeName", attributeName)
 	implementation = uti.ReplaceAll(implementation, "attributeType", attributeType)
@@ -995,48 +1654,70 @@ func (v *classes_) generateModules(
 ) (
 	implementation string,
 ) {
+	// Only a module that is used by code (not just by a comment) is needed.
+	class = v.removeComments(class)
+
+	// Import each module from the model that is needed by the class.
+	var moduleNames = col.List[string]()
 	var moduleDefinition = model.GetModuleDefinition()
 	var imports = moduleDefinition.GetOptionalImports()
 	if uti.IsDefined(imports) {
 		var modules = imports.GetModules().GetIterator()
 		for modules.HasNext() {
 			var module = modules.GetNext()
-			var moduleName = module.GetName()
-			var prefix = moduleName + "."
+			var moduleName = v.extractModuleName(module)
 			var modulePath = module.GetPath()
-			if sts.Contains(class, prefix) && !sts.Contains(implementation, prefix) {
-				var alias = v.getClass().moduleAlias_
-				alias = uti.ReplaceAll(alias, "moduleName", moduleName)
-				alias = uti.ReplaceAll(alias, "modulePath", modulePath)
-				implementation += alias
+			switch {
+			case moduleName == "_":
+				// A blank import is only needed for side effects that no
+				// generated class relies upon.
+				continue
+			case moduleName == ".":
+				if !v.usesDotImports_ {
+					continue
+				}
+			case !sts.Contains(class, moduleName+"."):
+				continue
+			case moduleNames.ContainsValue(moduleName):
+				continue
+			}
+			moduleNames.AppendValue(moduleName)
+			var alias = v.getClass().moduleAlias_
+			if uti.IsUndefined(module.GetOptionalAlias()) {
+				alias = v.getClass().modulePath_
 			}
+			alias = uti.ReplaceAll(alias, "moduleName", moduleName)
+			alias = uti.ReplaceAll(alias, "modulePath", modulePath)
+			implementation += alias
 		}
 	}
-	if sts.Contains(class, "fmt.") && !sts.Contains(implementation, "fmt.") {
+
+	// Import each standard module that is needed by the class.
+	if sts.Contains(class, "fmt.") && !moduleNames.ContainsValue("fmt") {
 		var alias = v.getClass().moduleAlias_
 		alias = uti.ReplaceAll(alias, "moduleName", "fmt")
 		alias = uti.ReplaceAll(alias, "modulePath", "\"fmt\"")
 		implementation += alias
 	}
-	if sts.Contains(class, "uti.") && !sts.Contains(implementation, "uti.") {
+	if sts.Contains(class, "uti.") && !moduleNames.ContainsValue("uti") {
 		var alias = v.getClass().moduleAlias_
 		alias = uti.ReplaceAll(alias, "moduleName", "uti")
 		alias = uti.ReplaceAll(alias, "modulePath", "\"github.com/craterdog/go-missing-utilities/v2\"")
 		implementation += alias
 	}
-	if sts.Contains(class, "col.") && !sts.Contains(implementation, "col.") {
+	if sts.Contains(class, "col.") && !moduleNames.ContainsValue("col") {
 		var alias = v.getClass().moduleAlias_
 		alias = uti.ReplaceAll(alias, "moduleName", "col")
 		alias = uti.ReplaceAll(alias, "modulePath", "\"github.com/craterdog/go-collection-framework/v4\"")
 		implementation += alias
 	}
-	if sts.Contains(class, "abs.") && !sts.Contains(implementation, "abs.") {
+	if sts.Contains(class, "abs.") && !moduleNames.ContainsValue("abs") {
 		var alias = v.getClass().moduleAlias_
 		alias = uti.ReplaceAll(alias, "moduleName", "abs")
 		alias = uti.ReplaceAll(alias, "modulePath", "\"github.com/craterdog/go-collection-framework/v4/collection\"")
 		implementation += alias
 	}
-	if sts.Contains(class, "syn.") && !sts.Contains(implementation, "syn.") {
+	if sts.Contains(class, "syn.") && !moduleNames.ContainsValue("syn") {
 		var alias = v.getClass().moduleAlias_
 		alias = uti.ReplaceAll(alias, "moduleName", "syn")
 		alias = uti.ReplaceAll(alias, "modulePath", "\"sync\"")
@@ -1048,6 +1729,38 @@ func (v *classes_) generateModules(
 	return implementation
 }
 
+func (v *classes_) generateNote(optionalNote string) (
+	note string,
+) {
+	// The note that documents a method in the model also documents its
+	// implementation.
+	if uti.IsDefined(optionalNote) {
+		var lines = sts.Split(optionalNote, "\n")
+		for _, line := range lines {
+			note += sts.TrimSpace(line) + "\n"
+		}
+	}
+	return note
+}
+
+func (v *classes_) generateOutputs(
+	parameterized ast.ParameterizedLike,
+) (
+	implementation string,
+) {
+	// Named results are declared just like method parameters.
+	for _, output := range v.extractOutputs(parameterized) {
+		var outputName = output.GetName()
+		var outputType = v.extractType(output.GetAbstraction())
+		var template = v.getClass().methodParameter_
+		template = uti.ReplaceAll(template, "parameterName", outputName)
+		template = uti.ReplaceAll(template, "parameterType", outputType)
+		implementation += template
+	}
+	implementation += "\n"
+	return implementation
+}
+
 func (v *classes_) generatePackageDeclaration(model ast.ModelLike) (
 	implementation string,
 ) {
@@ -1057,6 +1770,59 @@ func (v *classes_) generatePackageDeclaration(model ast.ModelLike) (
 	return implementation
 }
 
+func (v *classes_) generatePackageImports(
+	imports ast.ImportsLike,
+	source string,
+) ast.ImportsLike {
+	if uti.IsUndefined(imports) {
+		return imports
+	}
+
+	// Only a module that is used by code (not just by a comment) is needed.
+	source = v.removeComments(source)
+	var modules = col.List[ast.ModuleLike]()
+	var iterator = imports.GetModules().GetIterator()
+	for iterator.HasNext() {
+		var module = iterator.GetNext()
+		var moduleName = v.extractModuleName(module)
+		switch moduleName {
+		case "_", ".":
+			// The use of a blank or dot import cannot be determined.
+		default:
+			if !sts.Contains(source, moduleName+".") {
+				continue
+			}
+		}
+		modules.AppendValue(module)
+	}
+	if modules.IsEmpty() {
+		return nil
+	}
+	return ast.Imports().Make(modules)
+}
+
+func (v *classes_) generatePackageTypes(
+	typeSection ast.TypeSectionLike,
+) ast.TypeSectionLike {
+	if uti.IsUndefined(typeSection) {
+		return typeSection
+	}
+	var typeDefinitions = col.List[ast.TypeDefinitionLike]()
+	var iterator = typeSection.GetTypeDefinitions().GetIterator()
+	for iterator.HasNext() {
+		var typeDefinition = iterator.GetNext()
+		var underlying = typeDefinition.GetUnderlying()
+		if _, isStructure := underlying.GetAny().(ast.StructureLike); isStructure {
+			continue
+		}
+		typeDefinitions.AppendValue(typeDefinition)
+	}
+	if typeDefinitions.IsEmpty() {
+		return nil
+	}
+	return ast.TypeSection().Make(typeDefinitions)
+}
+
 func (v *classes_) generateParameters(
 	parameters abs.Sequential[ast.ParameterLike],
 ) (
@@ -1067,6 +1833,9 @@ func (v *classes_) generateParameters(
 		var parameter = iterator.GetNext()
 		var parameterName = parameter.GetName()
 		var parameterType = v.extractType(parameter.GetAbstraction())
+		if parameter.IsVariadic() {
+			parameterType = "..." + parameterType
+		}
 		var template = v.getClass().methodParameter_
 		template = uti.ReplaceAll(template, "parameterName", parameterName)
 		template = uti.ReplaceAll(template, "parameterType", parameterType)
@@ -1085,13 +1854,25 @@ func (v *classes_) generatePublicMethod(publicMethod ast.PublicMethodLike) (
 	var methodName = method.GetName()
 	var parameters = v.generateParameters(method.GetParameters())
 	var resultType = v.generateResult(method.GetOptionalResult())
+	var note = v.generateNote(method.GetOptionalNote())
 	implementation = v.getClass().instanceMethod_
 	if uti.IsDefined(resultType) {
 		implementation = v.getClass().instanceFunction_
 		implementation = uti.ReplaceAll(implementation, "resultType", resultType)
 	}
+	implementation = uti.ReplaceAll(implementation, "note", note)
 	implementation = uti.ReplaceAll(implementation, "methodName", methodName)
 	implementation = uti.ReplaceAll(implementation, "parameters", parameters)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"resultDeclarations",
+		v.generateResultDeclarations(method.GetOptionalResult()),
+	)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"resultValues",
+		v.generateResultValues(method.GetOptionalResult()),
+	)
 	return implementation
 }
 
@@ -1145,13 +1926,51 @@ func (v *classes_) generateResult(
 		switch actual := result.GetAny().(type) {
 		case ast.AbstractionLike:
 			implementation = v.extractType(actual)
+		case ast.TupleLike:
+			implementation = v.extractTuple(actual)
 		case ast.ParameterizedLike:
-			implementation = "(" + v.generateParameters(actual.GetParameters()) + "\n)"
+			implementation = "(" + v.generateOutputs(actual) + ")"
 		}
 	}
 	return implementation
 }
 
+func (v *classes_) generateResultDeclarations(
+	result ast.ResultLike,
+) (
+	implementation string,
+) {
+	var resultTypes = v.extractResultTypes(result)
+	var count = len(resultTypes)
+	for index, resultType := range resultTypes {
+		var resultName = v.extractResultName(index, count)
+		var declaration = v.getClass().resultDeclaration_
+		declaration = uti.ReplaceAll(declaration, "resultName", resultName)
+		declaration = uti.ReplaceAll(declaration, "resultType", resultType)
+		implementation += declaration
+	}
+	return implementation
+}
+
+func (v *classes_) generateResultValues(
+	result ast.ResultLike,
+) (
+	implementation string,
+) {
+	// Named results are returned implicitly.
+	var resultTypes = v.extractResultTypes(result)
+	var count = len(resultTypes)
+	var resultValues []string
+	for index := range resultTypes {
+		var resultName = v.extractResultName(index, count)
+		resultValues = append(resultValues, resultName+"_")
+	}
+	if count > 0 {
+		implementation = " " + sts.Join(resultValues, ", ")
+	}
+	return implementation
+}
+
 func (v *classes_) generateSetterMethod(setterMethod ast.SetterMethodLike) (
 	implementation string,
 ) {
@@ -1160,7 +1979,9 @@ func (v *classes_) generateSetterMethod(setterMethod ast.SetterMethodLike) (
 	var parameter = setterMethod.GetParameter()
 	var attributeType = v.extractType(parameter.GetAbstraction())
 	var attributeCheck = v.generateAttributeCheck(parameter)
+	var note = v.generateNote(setterMethod.GetOptionalNote())
 	implementation = v.getClass().setterMethod_
+	implementation = uti.ReplaceAll(implementation, "note", note)
 	implementation = uti.ReplaceAll(implementation, "methodName", methodName)
 	implementation = uti.ReplaceAll(implementation, "attributeName", attributeName)
 	implementation = uti.ReplaceAll(implementation, "attributeType", attributeType)
@@ -1168,14 +1989,134 @@ func (v *classes_) generateSetterMethod(setterMethod ast.SetterMethodLike) (
 	return implementation
 }
 
+func (v *classes_) generateStructure(
+	model ast.ModelLike,
+	declaration ast.DeclarationLike,
+	structure ast.StructureLike,
+) (
+	implementation string,
+) {
+	// Analyze the structure.
+	v.usesDotImports_ = false // This is set while the structure is generated.
+	v.isGeneric_ = uti.IsDefined(declaration.GetOptionalConstraints())
+
+	// Start with the structure template.
+	implementation = v.getClass().structureTemplate_
+	var notice = v.extractNotice(model)
+	implementation = uti.ReplaceAll(implementation, "notice", notice)
+
+	// Add in the package declaration.
+	var packageDeclaration = v.generatePackageDeclaration(model)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"packageDeclaration",
+		packageDeclaration,
+	)
+
+	// Add in the structure fields.
+	var fieldDeclarations = v.generateFieldDeclarations(structure)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"fieldDeclarations",
+		fieldDeclarations,
+	)
+
+	// Add in the constructor function.
+	var parameters = v.generateFieldParameters(structure)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"parameters",
+		parameters,
+	)
+	var fieldInitializations = v.generateFieldInitializations(structure)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"fieldInitializations",
+		fieldInitializations,
+	)
+
+	// Set the structure name.
+	implementation = uti.ReplaceAll(
+		implementation,
+		"comment",
+		declaration.GetComment(),
+	)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"structureName",
+		declaration.GetName(),
+	)
+
+	// Insert generics if necessary.
+	var constraints = v.generateConstraints(declaration)
+	var arguments = v.generateArguments(declaration)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"constraints",
+		constraints,
+	)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"arguments",
+		arguments,
+	)
+
+	// Insert any imported modules (this must be done last).
+	var moduleImports = v.generateImports(model, implementation)
+	implementation = uti.ReplaceAll(
+		implementation,
+		"moduleImports",
+		moduleImports,
+	)
+
+	// Align the fields and their initializations the way gofmt does.
+	var bytes, err = gof.Source([]byte(implementation))
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The generated %v structure is invalid: %v",
+			declaration.GetName(),
+			err,
+		)
+		panic(message)
+	}
+	implementation = string(bytes)
+	return implementation
+}
+
+func (v *classes_) removeComments(
+	class string,
+) (
+	code string,
+) {
+	var lines = sts.Split(class, "\n")
+	for _, line := range lines {
+		if sts.HasPrefix(sts.TrimSpace(line), "//") {
+			continue
+		}
+		code += line + "\n"
+	}
+	return code
+}
+
 func (v *classes_) replaceAbstractionType(
 	abstraction ast.AbstractionLike,
 	mappings abs.CatalogLike[string, ast.AbstractionLike],
 ) ast.AbstractionLike {
-	// Replace the generic type in a prefix with the concrete type.
-	var prefix = abstraction.GetOptionalPrefix()
-	if uti.IsDefined(prefix) {
+	// Replace the generic types in the prefixes with concrete types.
+	var prefixes = col.List[ast.PrefixLike]()
+	var iterator = abstraction.GetPrefixes().GetIterator()
+	for iterator.HasNext() {
+		var prefix = iterator.GetNext()
 		prefix = v.replacePrefixType(prefix, mappings)
+		prefixes.AppendValue(prefix)
+	}
+
+	// Replace the generic types in an inline function with concrete types.
+	var function = abstraction.GetOptionalFunction()
+	if uti.IsDefined(function) {
+		function = v.replaceFunctionTypes(function, mappings)
+		abstraction = ast.Abstraction().MakeWithFunction(prefixes, function)
+		return abstraction
 	}
 
 	// Replace the generic types in a sequence of arguments with concrete types.
@@ -1190,15 +2131,32 @@ func (v *classes_) replaceAbstractionType(
 	if uti.IsUndefined(suffix) {
 		var concreteType = mappings.GetValue(typeName)
 		if uti.IsDefined(concreteType) {
+			// eg. []T -> []*big.Int
+			prefixes.AppendValues(concreteType.GetPrefixes())
+			function = concreteType.GetOptionalFunction()
+			if uti.IsDefined(function) {
+				// eg. []T -> []func() int
+				abstraction = ast.Abstraction().MakeWithFunction(prefixes, function)
+				return abstraction
+			}
 			suffix = concreteType.GetOptionalSuffix()
 			typeName = concreteType.GetName()
-			arguments = concreteType.GetOptionalArguments()
+			if uti.IsDefined(concreteType.GetOptionalArguments()) {
+				arguments = concreteType.GetOptionalArguments()
+			}
+		}
+	} else {
+		// Replace the module name of a qualified type.
+		var concreteType = mappings.GetValue(typeName + "." + suffix.GetName())
+		if uti.IsDefined(concreteType) {
+			// eg. col.ListLike -> abs.ListLike
+			typeName = concreteType.GetName()
 		}
 	}
 
 	// Recreate the abstraction using its updated types.
 	abstraction = ast.Abstraction().Make(
-		prefix,
+		prefixes,
 		typeName,
 		suffix,
 		arguments,
@@ -1241,13 +2199,68 @@ func (v *classes_) replaceArgumentTypes(
 	return arguments
 }
 
+func (v *classes_) replaceFunctionTypes(
+	function ast.FunctionLike,
+	mappings abs.CatalogLike[string, ast.AbstractionLike],
+) ast.FunctionLike {
+	// Replace the generic type of the first argument with its concrete type.
+	var argument = function.GetOptionalArgument()
+	if uti.IsDefined(argument) {
+		argument = v.replaceArgumentType(argument, mappings)
+	}
+
+	// Replace the generic types of any additional arguments with concrete types.
+	var additionalArguments = col.List[ast.AdditionalArgumentLike]()
+	var iterator = function.GetAdditionalArguments().GetIterator()
+	for iterator.HasNext() {
+		var additionalArgument = iterator.GetNext()
+		var argument = additionalArgument.GetArgument()
+		argument = v.replaceArgumentType(argument, mappings)
+		additionalArgument = ast.AdditionalArgument().Make(argument)
+		additionalArguments.AppendValue(additionalArgument)
+	}
+
+	// Replace the generic types in the result with concrete types.
+	var result = function.GetOptionalResult()
+	result = v.replaceResultType(result, mappings)
+
+	// Construct the updated function.
+	function = ast.Function().Make(argument, additionalArguments, result)
+	return function
+}
+
+func (v *classes_) replaceOutputType(
+	output ast.OutputLike,
+	mappings abs.CatalogLike[string, ast.AbstractionLike],
+) ast.OutputLike {
+	var outputName = output.GetName()
+	var abstraction = output.GetAbstraction()
+	abstraction = v.replaceAbstractionType(abstraction, mappings)
+	output = ast.Output().Make(outputName, abstraction)
+	return output
+}
+
 func (v *classes_) replaceParameterizedTypes(
 	parameterized ast.ParameterizedLike,
 	mappings abs.CatalogLike[string, ast.AbstractionLike],
 ) ast.ParameterizedLike {
-	var parameters = parameterized.GetParameters()
-	var replacedParameters = v.replaceParameterTypes(parameters, mappings)
-	parameterized = ast.Parameterized().Make(replacedParameters)
+	// Replace the generic type of the first output with its concrete type.
+	var output = parameterized.GetOutput()
+	output = v.replaceOutputType(output, mappings)
+
+	// Replace the generic types of any additional outputs with concrete types.
+	var additionalOutputs = col.List[ast.AdditionalOutputLike]()
+	var iterator = parameterized.GetAdditionalOutputs().GetIterator()
+	for iterator.HasNext() {
+		var additionalOutput = iterator.GetNext()
+		var output = additionalOutput.GetOutput()
+		output = v.replaceOutputType(output, mappings)
+		additionalOutput = ast.AdditionalOutput().Make(output)
+		additionalOutputs.AppendValue(additionalOutput)
+	}
+
+	// Construct the updated parameterized result.
+	parameterized = ast.Parameterized().Make(output, additionalOutputs)
 	return parameterized
 }
 
@@ -1256,9 +2269,10 @@ func (v *classes_) replaceParameterType(
 	mappings abs.CatalogLike[string, ast.AbstractionLike],
 ) ast.ParameterLike {
 	var parameterName = parameter.GetName()
+	var variadic = parameter.IsVariadic()
 	var abstraction = parameter.GetAbstraction()
 	abstraction = v.replaceAbstractionType(abstraction, mappings)
-	parameter = ast.Parameter().Make(parameterName, abstraction)
+	parameter = ast.Parameter().Make(parameterName, variadic, abstraction)
 	return parameter
 }
 
@@ -1285,11 +2299,28 @@ func (v *classes_) replacePrefixType(
 		// eg. map[K]V -> map[string]int
 		var typeName = actual.GetName()
 		var concreteType = mappings.GetValue(typeName)
+		if uti.IsUndefined(concreteType) {
+			// The key type is not a generic type.
+			break
+		}
+		if !concreteType.GetPrefixes().IsEmpty() ||
+			uti.IsDefined(concreteType.GetOptionalFunction()) ||
+			uti.IsDefined(concreteType.GetOptionalSuffix()) ||
+			uti.IsDefined(concreteType.GetOptionalArguments()) {
+			// eg. map[K]V -> map[*big.Int]V cannot be represented by a map key.
+			var message = fmt.Sprintf(
+				"The map key %v cannot be bound to the type %v.",
+				typeName,
+				v.extractType(concreteType),
+			)
+			panic(message)
+		}
 		typeName = concreteType.GetName()
 		var map_ = ast.Map().Make(typeName)
 		prefix = ast.Prefix().Make(map_)
 	default:
-		// Ignore the rest since they don't contain any generic types.
+		// Ignore arrays, channels and pointers since they don't contain any
+		// generic types.
 	}
 	return prefix
 }
@@ -1307,6 +2338,10 @@ func (v *classes_) replaceResultType(
 		var abstraction = actual
 		abstraction = v.replaceAbstractionType(abstraction, mappings)
 		result = ast.Result().Make(abstraction)
+	case ast.TupleLike:
+		var tuple = actual
+		tuple = v.replaceTupleTypes(tuple, mappings)
+		result = ast.Result().Make(tuple)
 	case ast.ParameterizedLike:
 		var parameterized = actual
 		parameterized = v.replaceParameterizedTypes(parameterized, mappings)
@@ -1321,17 +2356,47 @@ func (v *classes_) replaceResultType(
 	return result
 }
 
+func (v *classes_) replaceTupleTypes(
+	tuple ast.TupleLike,
+	mappings abs.CatalogLike[string, ast.AbstractionLike],
+) ast.TupleLike {
+	// Replace the generic type of the first argument with its concrete type.
+	var argument = tuple.GetArgument()
+	argument = v.replaceArgumentType(argument, mappings)
+
+	// Replace the generic types of any additional arguments with concrete types.
+	var additionalArguments = col.List[ast.AdditionalArgumentLike]()
+	var iterator = tuple.GetAdditionalArguments().GetIterator()
+	for iterator.HasNext() {
+		var additionalArgument = iterator.GetNext()
+		var argument = additionalArgument.GetArgument()
+		argument = v.replaceArgumentType(argument, mappings)
+		additionalArgument = ast.AdditionalArgument().Make(argument)
+		additionalArguments.AppendValue(additionalArgument)
+	}
+
+	// Construct the updated tuple.
+	tuple = ast.Tuple().Make(argument, additionalArguments)
+	return tuple
+}
+
 // PRIVATE INTERFACE
 
 // Instance Structure
 
 type classes_ struct {
 	// Declare the instance attributes.
-	isGeneric_     bool
-	isIntrinsic_   bool
-	intrinsicType_ ast.AbstractionLike
-	constants_     abs.CatalogLike[string, string]
-	attributes_    abs.CatalogLike[string, string]
+	isGeneric_      bool
+	isIntrinsic_    bool
+	intrinsicType_  ast.AbstractionLike
+	constants_      abs.CatalogLike[string, string]
+	attributes_     abs.CatalogLike[string, string]
+	workspace_      gra.WorkspaceLike               // The workspace containing the model, if any.
+	modules_        abs.CatalogLike[string, string] // The imported module paths keyed by module name.
+	symbols_        gra.SymbolTableLike
+	aspects_        abs.SetLike[string]
+	methods_        abs.SetLike[string]
+	usesDotImports_ bool
 }
 
 // Class Structure
@@ -1339,9 +2404,15 @@ type classes_ struct {
 type classesClass_ struct {
 	// Declare the class constants.
 	classTemplate_           string
+	constantsTemplate_       string
+	constantDefinition_      string
+	structureTemplate_       string
+	fieldDeclaration_        string
+	fieldInitialization_     string
 	packageDeclaration_      string
 	moduleImports_           string
 	moduleAlias_             string
+	modulePath_              string
 	accessFunction_          string
 	constructorMethods_      string
 	constructorMethod_       string
@@ -1361,6 +2432,7 @@ type classesClass_ struct {
 	intrinsicMethod_         string
 	instanceMethod_          string
 	instanceFunction_        string
+	resultDeclaration_       string
 	methodParameter_         string
 	instanceIntrinsic_       string
 	instanceStructure_       string
@@ -1391,6 +2463,39 @@ var classesReference_ = &classesClass_{
 // PRIVATE INTERFACE<InstanceStructure><ClassStructure><ClassReference>
 `,
 
+	constantsTemplate_: `<Notice><PackageDeclaration><ModuleImports>
+
+// Constant Definitions<ConstantDefinitions>
+`,
+
+	constantDefinition_: `
+
+<Comment><Qualifier> <ConstantName><ConstantType> = <ConstantValue>`,
+
+	structureTemplate_: `<Notice><PackageDeclaration><ModuleImports>
+
+// Structure Definition
+
+<Comment>type <StructureName><Constraints> struct {<FieldDeclarations>
+}
+
+// Constructor Function
+
+/*
+Make<StructureName> returns a new structure containing the specified values.
+*/
+func Make<StructureName><Constraints>(<Parameters>) <StructureName><Arguments> {
+	return <StructureName><Arguments>{<FieldInitializations>
+	}
+}
+`,
+
+	fieldDeclaration_: `
+	<FieldName> <FieldType><FieldTag>`,
+
+	fieldInitialization_: `
+		<FieldName>: <fieldName_>,`,
+
 	packageDeclaration_: `
 package <~packageName>`,
 
@@ -1401,6 +2506,9 @@ import (<Modules>)`,
 	moduleAlias_: `
 	<~moduleName> <modulePath>`,
 
+	modulePath_: `
+	<modulePath>`,
+
 	accessFunction_: `
 
 // Access Function
@@ -1415,7 +2523,7 @@ func <~ClassName><Constraints>() <~ClassName>ClassLike<Arguments> {
 
 	constructorMethod_: `
 
-func (c *<~className>Class_<Arguments>) <MethodName>(<Parameters>) <~ClassName>Like<Arguments> {<InstanceInstantiation>
+<Note>func (c *<~className>Class_<Arguments>) <MethodName>(<Parameters>) <~ClassName>Like<Arguments> {<InstanceInstantiation>
 }`,
 
 	instanceInstantiation_: `
@@ -1442,7 +2550,7 @@ func (c *<~className>Class_<Arguments>) <MethodName>(<Parameters>) <~ClassName>L
 
 	constantMethod_: `
 
-func (c *<~className>Class_<Arguments>) <~MethodName>() <ResultType> {
+<Note>func (c *<~className>Class_<Arguments>) <~MethodName>() <ResultType> {
 	return c.<~methodName>_
 }`,
 
@@ -1452,10 +2560,9 @@ func (c *<~className>Class_<Arguments>) <~MethodName>() <ResultType> {
 
 	functionMethod_: `
 
-func (c *<~className>Class_<Arguments>) <~MethodName>(<Parameters>) <ResultType> {
-	var result_ <ResultType>
+<Note>func (c *<~className>Class_<Arguments>) <~MethodName>(<Parameters>) <ResultType> {<ResultDeclarations>
 	// TBD - Add the function implementation.
-	return result_
+	return<ResultValues>
 }`,
 
 	attributeMethods_: `
@@ -1464,13 +2571,13 @@ func (c *<~className>Class_<Arguments>) <~MethodName>(<Parameters>) <ResultType>
 
 	getterMethod_: `
 
-func (v <*><~className>_<Arguments>) <~MethodName>() <AttributeType> {
+<Note>func (v <*><~className>_<Arguments>) <~MethodName>() <AttributeType> {
 	return v.<~attributeName>_
 }`,
 
 	setterMethod_: `
 
-func (v <*><~className>_<Arguments>) <~MethodName>(
+<Note>func (v <*><~className>_<Arguments>) <~MethodName>(
 	<attributeName_> <AttributeType>,
 ) {<AttributeCheck>
 	v.<~attributeName>_ = <attributeName_>
@@ -1504,18 +2611,20 @@ func (v <*><~className>_<Arguments>) GetIntrinsic() <IntrinsicType> {
 
 	instanceMethod_: `
 
-func (v <*><~className>_<Arguments>) <~MethodName>(<Parameters>) {
+<Note>func (v <*><~className>_<Arguments>) <~MethodName>(<Parameters>) {
 	// TBD - Add the method implementation.
 }`,
 
 	instanceFunction_: `
 
-func (v <*><~className>_<Arguments>) <~MethodName>(<Parameters>) <ResultType> {
-	var result_ <ResultType>
+<Note>func (v <*><~className>_<Arguments>) <~MethodName>(<Parameters>) <ResultType> {<ResultDeclarations>
 	// TBD - Add the method implementation.
-	return result_
+	return<ResultValues>
 }`,
 
+	resultDeclaration_: `
+	var <~resultName>_ <ResultType>`,
+
 	methodParameter_: `
 	<parameterName_> <ParameterType>,`,
 
@@ -16,10 +16,18 @@ abstract syntax tree (AST) for this module:
   - Token captures the attributes associated with a parsed token.
   - Scanner is used to scan the source byte stream and recognize matching tokens.
   - Parser is used to process the token stream and generate the AST.
+  - SyntaxError captures the details of a syntax error found by the parser.
   - Validator is used to validate the semantics associated with an AST.
   - Formatter is used to format an AST back into a canonical version of its source.
   - Visitor walks the AST and calls processor methods for each node in the tree.
   - Processor provides empty processor methods to be inherited by the processors.
+  - Workspace contains the models of all packages in a module, keyed by package.
+  - Resolver binds each type imported from another package to its declaration.
+  - ResolutionError captures the details of a type reference that is unresolved.
+  - SymbolTable indexes each name that is declared by a model.
+  - Symbol captures the kind and declaration associated with a declared name.
+  - CrossReference indexes each usage of a type name within a model.
+  - Usage captures where a type name is used within a model.
 
 For detailed documentation on this package refer to the wiki:
   - https://github.com/craterdog/go-model-framework/wiki
@@ -42,6 +50,24 @@ import (
 
 // Type Definitions
 
+/*
+SymbolKind is a constrained type representing the kind of name that is declared
+by a model.
+*/
+type SymbolKind uint8
+
+const (
+	UnknownSymbol SymbolKind = iota
+	AspectSymbol
+	ClassSymbol
+	ConstantSymbol
+	FunctionalSymbol
+	InstanceSymbol
+	ParameterSymbol
+	TypeSymbol
+	ValueSymbol
+)
+
 /*
 TokenType is a constrained type representing any token type recognized by a
 scanner.
@@ -54,12 +80,28 @@ const (
 	DelimiterToken
 	NameToken
 	NewlineToken
+	NoteToken
+	NumberToken
 	PathToken
+	RuneToken
 	SpaceToken
+	TagToken
 )
 
 // Class Definitions
 
+/*
+CrossReferenceClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete cross-reference-like class.
+*/
+type CrossReferenceClassLike interface {
+	// Constructor Methods
+	Make(
+		model ast.ModelLike,
+	) CrossReferenceLike
+}
+
 /*
 FormatterClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -90,6 +132,32 @@ type ProcessorClassLike interface {
 	Make() ProcessorLike
 }
 
+/*
+ResolutionErrorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete resolution-error-like class.
+*/
+type ResolutionErrorClassLike interface {
+	// Constructor Methods
+	Make(
+		packagePath string,
+		abstraction ast.AbstractionLike,
+		optionalModulePath string,
+	) ResolutionErrorLike
+}
+
+/*
+ResolverClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete resolver-like class.
+*/
+type ResolverClassLike interface {
+	// Constructor Methods
+	Make(
+		workspace WorkspaceLike,
+	) ResolverLike
+}
+
 /*
 ScannerClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -100,6 +168,13 @@ FormatToken() returns a formatted string containing the attributes of the token.
 FormatType() returns the string version of the token type.
 
 MatchesType() determines whether or not a token value is of a specified type.
+
+ScanTokens() returns the sequence of tokens found in a source string, ending
+with an error token if an unrecognized character is found.
+
+A scanner created using Make() scans its source in a separate goroutine and adds
+each token to the specified queue.  A scanner created using MakeWithSource()
+only scans the next token in its source when ScanToken() is called.
 */
 type ScannerClassLike interface {
 	// Constructor Methods
@@ -107,6 +182,12 @@ type ScannerClassLike interface {
 		source string,
 		tokens abs.QueueLike[TokenLike],
 	) ScannerLike
+	MakeWithSource(
+		source string,
+	) ScannerLike
+
+	// Constant Methods
+	TabSize() uint
 
 	// Function Methods
 	FormatToken(
@@ -119,6 +200,59 @@ type ScannerClassLike interface {
 		tokenValue string,
 		tokenType TokenType,
 	) bool
+	ScanTokens(
+		source string,
+	) abs.Sequential[TokenLike]
+}
+
+/*
+SymbolClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete symbol-like class.
+*/
+type SymbolClassLike interface {
+	// Constructor Methods
+	Make(
+		name string,
+		kind SymbolKind,
+		optionalDeclaration ast.DeclarationLike,
+		definition ast.Spanned,
+	) SymbolLike
+}
+
+/*
+SymbolTableClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete symbol-table-like class.  The following functions are supported:
+
+IsPredeclared() determines whether or not a type name is predeclared by Go.
+*/
+type SymbolTableClassLike interface {
+	// Constructor Methods
+	Make(
+		model ast.ModelLike,
+	) SymbolTableLike
+
+	// Function Methods
+	IsPredeclared(
+		name string,
+	) bool
+}
+
+/*
+SyntaxErrorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete syntax-error-like class.
+*/
+type SyntaxErrorClassLike interface {
+	// Constructor Methods
+	Make(
+		line uint,
+		position uint,
+		optionalToken TokenLike,
+		optionalRuleName string,
+		optionalDefinition string,
+	) SyntaxErrorLike
 }
 
 /*
@@ -131,11 +265,26 @@ type TokenClassLike interface {
 	Make(
 		line uint,
 		position uint,
+		offset uint,
 		type_ TokenType,
 		value string,
 	) TokenLike
 }
 
+/*
+UsageClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete usage-like class.
+*/
+type UsageClassLike interface {
+	// Constructor Methods
+	Make(
+		abstraction ast.AbstractionLike,
+		optionalDeclaration ast.DeclarationLike,
+		optionalMethod string,
+	) UsageLike
+}
+
 /*
 ValidatorClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -158,8 +307,52 @@ type VisitorClassLike interface {
 	) VisitorLike
 }
 
+/*
+WorkspaceClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete workspace-like class.  ModuleName() returns the name that qualifies the
+types imported from a module, or an empty string if its package name cannot be
+determined without an alias.
+*/
+type WorkspaceClassLike interface {
+	// Constructor Methods
+	Make(
+		modulePath string,
+		models abs.CatalogLike[string, ast.ModelLike],
+	) WorkspaceLike
+
+	// Function Methods
+	ModuleName(
+		module ast.ModuleLike,
+		optionalWorkspace WorkspaceLike,
+	) string
+}
+
 // Instance Definitions
 
+/*
+CrossReferenceLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete cross-reference-like class.  A type name that is
+imported from another package is qualified by its module name, for example
+"ast.AbstractionLike".  An inline function type is not indexed itself, only the
+types of its arguments and results are.
+*/
+type CrossReferenceLike interface {
+	// Public Methods
+	GetClass() CrossReferenceClassLike
+	GetTypeNames() abs.Sequential[string]
+	GetUsages(
+		typeName string,
+	) abs.Sequential[UsageLike]
+
+	// Attribute Methods
+	GetModel() ast.ModelLike
+
+	// Aspect Methods
+	Methodical
+}
+
 /*
 FormatterLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -179,14 +372,38 @@ type FormatterLike interface {
 /*
 ParserLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
-instance of a concrete parser-like class.
+instance of a concrete parser-like class.  ParseDirectory() concurrently parses
+the "Package.go" model file of each package in a directory tree.
+TryParseDirectory() returns an error describing every model file that could not
+be parsed rather than panicking on the first one.
 */
 type ParserLike interface {
 	// Public Methods
 	GetClass() ParserClassLike
+	ParseDirectory(
+		directory string,
+	) WorkspaceLike
 	ParseSource(
 		source string,
 	) ast.ModelLike
+	ParseSourceWithRecovery(
+		source string,
+	) (
+		model ast.ModelLike,
+		errors abs.Sequential[SyntaxErrorLike],
+	)
+	TryParseDirectory(
+		directory string,
+	) (
+		workspace WorkspaceLike,
+		err error,
+	)
+	TryParseSource(
+		source string,
+	) (
+		model ast.ModelLike,
+		err error,
+	)
 }
 
 /*
@@ -202,14 +419,137 @@ type ProcessorLike interface {
 	Methodical
 }
 
+/*
+ResolutionErrorLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete resolution-error-like class.  A resolution error has no
+module path when the module named by the type reference is not imported.
+*/
+type ResolutionErrorLike interface {
+	// Public Methods
+	GetClass() ResolutionErrorClassLike
+	Error() string
+
+	// Attribute Methods
+	GetPackagePath() string
+	GetAbstraction() ast.AbstractionLike
+	GetOptionalModulePath() string
+}
+
+/*
+ResolverLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete resolver-like class.  Only references to packages that
+are contained in the workspace are resolved.  The types imported by a dot import
+are not qualified by a module name so references to them are not resolved.
+*/
+type ResolverLike interface {
+	// Public Methods
+	GetClass() ResolverClassLike
+	ResolveWorkspace() abs.Sequential[ResolutionErrorLike]
+	GetDeclaration(
+		abstraction ast.AbstractionLike,
+	) ast.DeclarationLike
+
+	// Attribute Methods
+	GetWorkspace() WorkspaceLike
+
+	// Aspect Methods
+	Methodical
+}
+
 /*
 ScannerLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
-instance of a concrete scanner-like class.
+instance of a concrete scanner-like class.  ScanToken() returns the next token
+in the source, or nil once the end of the source has been reached or an error
+token has been returned.
 */
 type ScannerLike interface {
 	// Public Methods
 	GetClass() ScannerClassLike
+	ScanToken() TokenLike
+}
+
+/*
+SymbolLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete symbol-like class.  The declaration of a generic
+parameter or enumeration value is the declaration of the type that defines it,
+and a constant definition has no declaration.  The definition is the node in
+the model that declares the name.
+*/
+type SymbolLike interface {
+	// Public Methods
+	GetClass() SymbolClassLike
+
+	// Attribute Methods
+	GetName() string
+	GetKind() SymbolKind
+	GetOptionalDeclaration() ast.DeclarationLike
+	GetDefinition() ast.Spanned
+}
+
+/*
+SymbolTableLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete symbol-table-like class.  Generic parameters are scoped
+to their declarations so they are only returned by the LookupParameter(),
+LookupSymbols() and GetSymbols() methods.  IsDeclared() determines whether or
+not a type name is predeclared by Go, declared by the model or a generic
+parameter of the specified declaration.
+*/
+type SymbolTableLike interface {
+	// Public Methods
+	GetClass() SymbolTableClassLike
+	GetKind(
+		name string,
+	) SymbolKind
+	GetSymbols(
+		kind SymbolKind,
+	) abs.Sequential[SymbolLike]
+	IsDeclared(
+		name string,
+		optionalDeclaration ast.DeclarationLike,
+	) bool
+	LookupParameter(
+		declaration ast.DeclarationLike,
+		name string,
+	) SymbolLike
+	LookupSymbol(
+		name string,
+	) SymbolLike
+	LookupSymbols(
+		name string,
+	) abs.Sequential[SymbolLike]
+	LookupType(
+		name string,
+	) SymbolLike
+
+	// Attribute Methods
+	GetModel() ast.ModelLike
+
+	// Aspect Methods
+	Methodical
+}
+
+/*
+SyntaxErrorLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete syntax-error-like class.  It implements the Go error
+interface so that it can be returned wherever an error is expected.
+*/
+type SyntaxErrorLike interface {
+	// Public Methods
+	GetClass() SyntaxErrorClassLike
+	Error() string
+
+	// Attribute Methods
+	GetLine() uint
+	GetPosition() uint
+	GetOptionalToken() TokenLike
+	GetOptionalRuleName() string
+	GetOptionalDefinition() string
 }
 
 /*
@@ -224,10 +564,28 @@ type TokenLike interface {
 	// Attribute Methods
 	GetLine() uint
 	GetPosition() uint
+	GetOffset() uint
 	GetType() TokenType
 	GetValue() string
 }
 
+/*
+UsageLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete usage-like class.  A usage has no declaration when it
+occurs in a constant definition, and no method when it occurs outside of one.
+*/
+type UsageLike interface {
+	// Public Methods
+	GetClass() UsageClassLike
+	GetSpan() ast.SpanLike
+
+	// Attribute Methods
+	GetAbstraction() ast.AbstractionLike
+	GetOptionalDeclaration() ast.DeclarationLike
+	GetOptionalMethod() string
+}
+
 /*
 ValidatorLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -257,6 +615,24 @@ type VisitorLike interface {
 	)
 }
 
+/*
+WorkspaceLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete workspace-like class.  A workspace contains the model of
+each package in a Go module keyed by the import path of the package.
+*/
+type WorkspaceLike interface {
+	// Public Methods
+	GetClass() WorkspaceClassLike
+	GetModel(
+		packagePath string,
+	) ast.ModelLike
+
+	// Attribute Methods
+	GetModulePath() string
+	GetModels() abs.CatalogLike[string, ast.ModelLike]
+}
+
 // Aspect Definitions
 
 /*
@@ -273,12 +649,24 @@ type Methodical interface {
 	ProcessNewline(
 		newline string,
 	)
+	ProcessNote(
+		note string,
+	)
+	ProcessNumber(
+		number string,
+	)
 	ProcessPath(
 		path string,
 	)
+	ProcessRune(
+		rune_ string,
+	)
 	ProcessSpace(
 		space string,
 	)
+	ProcessTag(
+		tag string,
+	)
 	PreprocessAbstraction(
 		abstraction ast.AbstractionLike,
 	)
@@ -314,6 +702,45 @@ type Methodical interface {
 		index uint,
 		size uint,
 	)
+	PreprocessAdditionalExpression(
+		additionalExpression ast.AdditionalExpressionLike,
+		index uint,
+		size uint,
+	)
+	ProcessAdditionalExpressionSlot(
+		slot uint,
+	)
+	PostprocessAdditionalExpression(
+		additionalExpression ast.AdditionalExpressionLike,
+		index uint,
+		size uint,
+	)
+	PreprocessAdditionalOutput(
+		additionalOutput ast.AdditionalOutputLike,
+		index uint,
+		size uint,
+	)
+	ProcessAdditionalOutputSlot(
+		slot uint,
+	)
+	PostprocessAdditionalOutput(
+		additionalOutput ast.AdditionalOutputLike,
+		index uint,
+		size uint,
+	)
+	PreprocessAdditionalTerm(
+		additionalTerm ast.AdditionalTermLike,
+		index uint,
+		size uint,
+	)
+	ProcessAdditionalTermSlot(
+		slot uint,
+	)
+	PostprocessAdditionalTerm(
+		additionalTerm ast.AdditionalTermLike,
+		index uint,
+		size uint,
+	)
 	PreprocessAdditionalValue(
 		additionalValue ast.AdditionalValueLike,
 		index uint,
@@ -327,6 +754,15 @@ type Methodical interface {
 		index uint,
 		size uint,
 	)
+	PreprocessAlias(
+		alias ast.AliasLike,
+	)
+	ProcessAliasSlot(
+		slot uint,
+	)
+	PostprocessAlias(
+		alias ast.AliasLike,
+	)
 	PreprocessArgument(
 		argument ast.ArgumentLike,
 	)
@@ -473,6 +909,19 @@ type Methodical interface {
 	PostprocessClassSection(
 		classSection ast.ClassSectionLike,
 	)
+	PreprocessConstantDefinition(
+		constantDefinition ast.ConstantDefinitionLike,
+		index uint,
+		size uint,
+	)
+	ProcessConstantDefinitionSlot(
+		slot uint,
+	)
+	PostprocessConstantDefinition(
+		constantDefinition ast.ConstantDefinitionLike,
+		index uint,
+		size uint,
+	)
 	PreprocessConstantMethod(
 		constantMethod ast.ConstantMethodLike,
 		index uint,
@@ -486,6 +935,15 @@ type Methodical interface {
 		index uint,
 		size uint,
 	)
+	PreprocessConstantSection(
+		constantSection ast.ConstantSectionLike,
+	)
+	ProcessConstantSectionSlot(
+		slot uint,
+	)
+	PostprocessConstantSection(
+		constantSection ast.ConstantSectionLike,
+	)
 	PreprocessConstantSubsection(
 		constantSubsection ast.ConstantSubsectionLike,
 	)
@@ -553,6 +1011,37 @@ type Methodical interface {
 	PostprocessEnumeration(
 		enumeration ast.EnumerationLike,
 	)
+	PreprocessExpression(
+		expression ast.ExpressionLike,
+	)
+	ProcessExpressionSlot(
+		slot uint,
+	)
+	PostprocessExpression(
+		expression ast.ExpressionLike,
+	)
+	PreprocessField(
+		field ast.FieldLike,
+		index uint,
+		size uint,
+	)
+	ProcessFieldSlot(
+		slot uint,
+	)
+	PostprocessField(
+		field ast.FieldLike,
+		index uint,
+		size uint,
+	)
+	PreprocessFunction(
+		function ast.FunctionLike,
+	)
+	ProcessFunctionSlot(
+		slot uint,
+	)
+	PostprocessFunction(
+		function ast.FunctionLike,
+	)
 	PreprocessFunctionMethod(
 		functionMethod ast.FunctionMethodLike,
 		index uint,
@@ -624,6 +1113,15 @@ type Methodical interface {
 	PostprocessImports(
 		imports ast.ImportsLike,
 	)
+	PreprocessInitializer(
+		initializer ast.InitializerLike,
+	)
+	ProcessInitializerSlot(
+		slot uint,
+	)
+	PostprocessInitializer(
+		initializer ast.InitializerLike,
+	)
 	PreprocessInstanceDefinition(
 		instanceDefinition ast.InstanceDefinitionLike,
 		index uint,
@@ -664,6 +1162,15 @@ type Methodical interface {
 	PostprocessInterfaceDefinitions(
 		interfaceDefinitions ast.InterfaceDefinitionsLike,
 	)
+	PreprocessInvocation(
+		invocation ast.InvocationLike,
+	)
+	ProcessInvocationSlot(
+		slot uint,
+	)
+	PostprocessInvocation(
+		invocation ast.InvocationLike,
+	)
 	PreprocessMap(
 		map_ ast.MapLike,
 	)
@@ -731,6 +1238,46 @@ type Methodical interface {
 	PostprocessNotice(
 		notice ast.NoticeLike,
 	)
+	PreprocessOperand(
+		operand ast.OperandLike,
+	)
+	ProcessOperandSlot(
+		slot uint,
+	)
+	PostprocessOperand(
+		operand ast.OperandLike,
+	)
+	PreprocessOperation(
+		operation ast.OperationLike,
+		index uint,
+		size uint,
+	)
+	ProcessOperationSlot(
+		slot uint,
+	)
+	PostprocessOperation(
+		operation ast.OperationLike,
+		index uint,
+		size uint,
+	)
+	PreprocessOperator(
+		operator ast.OperatorLike,
+	)
+	ProcessOperatorSlot(
+		slot uint,
+	)
+	PostprocessOperator(
+		operator ast.OperatorLike,
+	)
+	PreprocessOutput(
+		output ast.OutputLike,
+	)
+	ProcessOutputSlot(
+		slot uint,
+	)
+	PostprocessOutput(
+		output ast.OutputLike,
+	)
 	PreprocessParameter(
 		parameter ast.ParameterLike,
 		index uint,
@@ -753,14 +1300,36 @@ type Methodical interface {
 	PostprocessParameterized(
 		parameterized ast.ParameterizedLike,
 	)
+	PreprocessPointer(
+		pointer ast.PointerLike,
+	)
+	ProcessPointerSlot(
+		slot uint,
+	)
+	PostprocessPointer(
+		pointer ast.PointerLike,
+	)
+	PreprocessPrecedence(
+		precedence ast.PrecedenceLike,
+	)
+	ProcessPrecedenceSlot(
+		slot uint,
+	)
+	PostprocessPrecedence(
+		precedence ast.PrecedenceLike,
+	)
 	PreprocessPrefix(
 		prefix ast.PrefixLike,
+		index uint,
+		size uint,
 	)
 	ProcessPrefixSlot(
 		slot uint,
 	)
 	PostprocessPrefix(
 		prefix ast.PrefixLike,
+		index uint,
+		size uint,
 	)
 	PreprocessPrimitiveDefinitions(
 		primitiveDefinitions ast.PrimitiveDefinitionsLike,
@@ -793,6 +1362,24 @@ type Methodical interface {
 	PostprocessPublicSubsection(
 		publicSubsection ast.PublicSubsectionLike,
 	)
+	PreprocessQualifier(
+		qualifier ast.QualifierLike,
+	)
+	ProcessQualifierSlot(
+		slot uint,
+	)
+	PostprocessQualifier(
+		qualifier ast.QualifierLike,
+	)
+	PreprocessReference(
+		reference ast.ReferenceLike,
+	)
+	ProcessReferenceSlot(
+		slot uint,
+	)
+	PostprocessReference(
+		reference ast.ReferenceLike,
+	)
 	PreprocessResult(
 		result ast.ResultLike,
 	)
@@ -811,6 +1398,24 @@ type Methodical interface {
 	PostprocessSetterMethod(
 		setterMethod ast.SetterMethodLike,
 	)
+	PreprocessSize(
+		size ast.SizeLike,
+	)
+	ProcessSizeSlot(
+		slot uint,
+	)
+	PostprocessSize(
+		size ast.SizeLike,
+	)
+	PreprocessStructure(
+		structure ast.StructureLike,
+	)
+	ProcessStructureSlot(
+		slot uint,
+	)
+	PostprocessStructure(
+		structure ast.StructureLike,
+	)
 	PreprocessSuffix(
 		suffix ast.SuffixLike,
 	)
@@ -820,6 +1425,24 @@ type Methodical interface {
 	PostprocessSuffix(
 		suffix ast.SuffixLike,
 	)
+	PreprocessTerm(
+		term ast.TermLike,
+	)
+	ProcessTermSlot(
+		slot uint,
+	)
+	PostprocessTerm(
+		term ast.TermLike,
+	)
+	PreprocessTuple(
+		tuple ast.TupleLike,
+	)
+	ProcessTupleSlot(
+		slot uint,
+	)
+	PostprocessTuple(
+		tuple ast.TupleLike,
+	)
 	PreprocessTypeDefinition(
 		typeDefinition ast.TypeDefinitionLike,
 		index uint,
@@ -842,6 +1465,24 @@ type Methodical interface {
 	PostprocessTypeSection(
 		typeSection ast.TypeSectionLike,
 	)
+	PreprocessUnary(
+		unary ast.UnaryLike,
+	)
+	ProcessUnarySlot(
+		slot uint,
+	)
+	PostprocessUnary(
+		unary ast.UnaryLike,
+	)
+	PreprocessUnderlying(
+		underlying ast.UnderlyingLike,
+	)
+	ProcessUnderlyingSlot(
+		slot uint,
+	)
+	PostprocessUnderlying(
+		underlying ast.UnderlyingLike,
+	)
 	PreprocessValue(
 		value ast.ValueLike,
 	)
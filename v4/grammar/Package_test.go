@@ -13,10 +13,15 @@
 package grammar_test
 
 import (
+	ers "errors"
 	fmt "fmt"
+	ast "github.com/craterdog/go-model-framework/v4/ast"
 	gra "github.com/craterdog/go-model-framework/v4/grammar"
 	ass "github.com/stretchr/testify/assert"
 	osx "os"
+	fil "path/filepath"
+	run "runtime"
+	sts "strings"
 	tes "testing"
 )
 
@@ -52,3 +57,1303 @@ func TestRoundTrips(t *tes.T) {
 	}
 	fmt.Println("Done.")
 }
+
+const badSource = `/*
+ Notice
+*/
+
+/*
+Package "example" is a class model.
+*/
+package example
+
+// Class Definitions
+
+/*
+ExampleClassLike is a class interface.
+*/
+type ExampleClassLike interface {
+	// Constructor Methods
+	Make(
+		name string
+	) ExampleLike
+}
+`
+
+func TestSyntaxErrors(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var model, err = parser.TryParseSource(badSource)
+	ass.Nil(t, model)
+	var syntaxError, ok = err.(gra.SyntaxErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, uint(19), syntaxError.GetLine())
+	ass.Equal(t, uint(5), syntaxError.GetPosition())
+	ass.Equal(t, "Parameter", syntaxError.GetOptionalRuleName())
+	ass.Equal(t, `name "..."? Abstraction ","`, syntaxError.GetOptionalDefinition())
+	ass.Equal(t, ")", syntaxError.GetOptionalToken().GetValue())
+
+	// Reaching the end of the source is also reported as a syntax error.
+	model, err = parser.TryParseSource(badSource[:sts.Index(badSource, "/*\nExampleClassLike")])
+	ass.Nil(t, model)
+	syntaxError, ok = err.(gra.SyntaxErrorLike)
+	ass.True(t, ok)
+	ass.Nil(t, syntaxError.GetOptionalToken())
+}
+
+const typoSource = `/*
+ Notice
+*/
+
+/*
+Package "example" is a class model.
+*/
+package example
+
+// Class Definitions
+
+/*
+AlphaClassLike is a class interface.
+*/
+type AlphaClassLike interface {
+	// Constructor Methods
+	Make(
+		name string
+	) AlphaLike
+}
+
+/*
+BetaClassLike is a class interface.
+*/
+type BetaClassLike interface {
+	// Constructor Methods
+	Make() BetaLike
+}
+
+/*
+GammaClassLike is a class interface.
+*/
+type GammaClassLike interface {
+	// Constructor Methods
+	Make() GammaLike,
+}
+
+// Instance Definitions
+
+/*
+AlphaLike is an instance interface.
+*/
+type AlphaLike interface {
+	// Public Methods
+	GetClass( AlphaClassLike
+}
+`
+
+func TestSyntaxErrorRecovery(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var model, errors = parser.ParseSourceWithRecovery(typoSource)
+	ass.Nil(t, model)
+	ass.Equal(t, 3, errors.GetSize())
+	var iterator = errors.GetIterator()
+	var lines = []uint{19, 35, 46}
+	for _, line := range lines {
+		var syntaxError = iterator.GetNext()
+		ass.Equal(t, line, syntaxError.GetLine())
+	}
+}
+
+func TestLexicalErrorRecovery(t *tes.T) {
+	// Only the lexical error is reported since the scanner stops there.
+	var source = fixture{constructors: "\tMake() $BetaLike\n"}.source()
+	var parser = gra.Parser().Make()
+	var model, errors = parser.ParseSourceWithRecovery(source)
+	ass.Nil(t, model)
+	ass.Equal(t, 1, errors.GetSize())
+	var syntaxError = errors.GetIterator().GetNext()
+	ass.Equal(t, gra.ErrorToken, syntaxError.GetOptionalToken().GetType())
+	ass.Equal(t, uint(17), syntaxError.GetLine())
+}
+
+const skippedSource = `/*
+ Notice
+*/
+
+/*
+Package "example" is a class model.
+*/
+package example
+
+// Class Definitions
+
+/*
+AlphaClassLike is a class interface.
+*/
+type AlphaClassLike interface {
+	// Constructor Methods
+	Make(
+		name string
+	) $AlphaLike
+}
+`
+
+func TestSkippedLexicalErrors(t *tes.T) {
+	// A lexical error within the skipped tokens is reported after the syntax error.
+	var parser = gra.Parser().Make()
+	var model, errors = parser.ParseSourceWithRecovery(skippedSource)
+	ass.Nil(t, model)
+	ass.Equal(t, 2, errors.GetSize())
+	var iterator = errors.GetIterator()
+	var syntaxError = iterator.GetNext()
+	ass.Equal(t, uint(19), syntaxError.GetLine())
+	syntaxError = iterator.GetNext()
+	ass.Equal(t, gra.ErrorToken, syntaxError.GetOptionalToken().GetType())
+	ass.Equal(t, uint(19), syntaxError.GetLine())
+	ass.Equal(t, uint(7), syntaxError.GetPosition())
+}
+
+// A fixture describes a model defining the BetaClassLike and BetaLike
+// interfaces along with whatever each test adds to them.
+type fixture struct {
+	packageName  string // The name of the package, "example" by default.
+	imports      string // The modules listed in the imports.
+	definitions  string // The primitive definition sections.
+	comment      string // The comment for the BetaClassLike interface.
+	parameters   string // The generic parameters of both interfaces.
+	constructors string // The constructor methods, "Make() BetaLike" by default.
+	methods      string // The public methods, "GetClass() BetaClassLike" by default.
+	aspects      string // The aspect definitions section.
+}
+
+const fixtureTemplate = `/*
+ Notice
+*/
+
+/*
+Package "<PackageName>" is a class model.
+*/
+package <PackageName>
+<Imports>
+<Definitions>// Class Definitions
+
+/*
+<Comment>
+*/
+type BetaClassLike<Parameters> interface {
+	// Constructor Methods
+<Constructors>}
+
+// Instance Definitions
+
+/*
+BetaLike is an instance interface.
+*/
+type BetaLike<Parameters> interface {
+	// Public Methods
+<Methods>}
+<Aspects>`
+
+func (v fixture) source() string {
+	var packageName = v.packageName
+	if packageName == "" {
+		packageName = "example"
+	}
+	var imports string
+	if v.imports != "" {
+		imports = "\nimport (\n" + v.imports + ")\n"
+	}
+	var definitions string
+	if v.definitions != "" {
+		definitions = v.definitions + "\n\n"
+	}
+	var comment = v.comment
+	if comment == "" {
+		comment = "BetaClassLike is a class interface."
+	}
+	var constructors = v.constructors
+	if constructors == "" {
+		constructors = "\tMake() BetaLike\n"
+	}
+	var methods = v.methods
+	if methods == "" {
+		methods = "\tGetClass() BetaClassLike\n"
+	}
+	var aspects string
+	if v.aspects != "" {
+		aspects = "\n" + v.aspects
+	}
+	var source = fixtureTemplate
+	source = sts.ReplaceAll(source, "<PackageName>", packageName)
+	source = sts.ReplaceAll(source, "<Imports>", imports)
+	source = sts.ReplaceAll(source, "<Definitions>", definitions)
+	source = sts.ReplaceAll(source, "<Comment>", comment)
+	source = sts.ReplaceAll(source, "<Parameters>", v.parameters)
+	source = sts.ReplaceAll(source, "<Constructors>", constructors)
+	source = sts.ReplaceAll(source, "<Methods>", methods)
+	source = sts.ReplaceAll(source, "<Aspects>", aspects)
+	return source
+}
+
+// The spans are checked against the base fixture.
+var spanSource = fixture{}.source()
+
+func TestSourceSpans(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(spanSource)
+	var classSection = model.GetInterfaceDefinitions().GetClassSection()
+	var classDefinition = classSection.GetClassDefinitions().AsArray()[0]
+	var span = classDefinition.GetSpan()
+	ass.Equal(t, uint(12), span.GetStart().GetLine())
+	ass.Equal(t, uint(1), span.GetStart().GetColumn())
+	ass.Equal(t, uint(18), span.GetEnd().GetLine())
+	ass.Equal(t, uint(2), span.GetEnd().GetColumn())
+
+	// The byte offsets delimit the source of each node.
+	var classMethods = classDefinition.GetClassMethods()
+	var constructorSubsection = classMethods.GetConstructorSubsection()
+	var constructorMethod = constructorSubsection.GetConstructorMethods().AsArray()[0]
+	span = constructorMethod.GetSpan()
+	ass.Equal(t, uint(17), span.GetStart().GetLine())
+	ass.Equal(t, uint(17), span.GetEnd().GetLine())
+	var first = span.GetStart().GetOffset()
+	var last = span.GetEnd().GetOffset()
+	ass.Equal(t, "Make() BetaLike", spanSource[first:last])
+
+	// Tabs advance the column to the next tab stop.
+	ass.Equal(t, uint(5), span.GetStart().GetColumn())
+}
+
+func TestTabsInComments(t *tes.T) {
+	var source = fixture{
+		comment: "BetaClassLike is a class interface:\n\t- with an indented\tcomment",
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+}
+
+func TestVariadicParameters(t *tes.T) {
+	var source = fixture{
+		constructors: "\tMake(\n\t\tname string,\n\t\tvalues ...any,\n\t) BetaLike\n",
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// Only the last parameter may be variadic.
+	source = fixture{
+		constructors: "\tMake(\n\t\tvalues ...any,\n\t\tname string,\n\t) BetaLike\n",
+	}.source()
+	model = parser.ParseSource(source)
+	ass.Panics(t, func() { validator.ValidateModel(model) })
+}
+
+func TestPointerPrefixes(t *tes.T) {
+	var source = fixture{
+		imports:      "\tbig \"math/big\"\n",
+		constructors: "\tMake(\n\t\tvalue *big.Int,\n\t) *BetaLike\n",
+		methods: `	GetClass() BetaClassLike
+	GetBytes() *[]byte
+	GetValues() []*big.Int
+	GetIndex() map[string]*BetaLike
+	GetMatrix() [][4]float64
+`,
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// Each prefix of an abstraction is kept in order.
+	var instanceSection = model.GetInterfaceDefinitions().GetInstanceSection()
+	var instanceDefinition = instanceSection.GetInstanceDefinitions().AsArray()[0]
+	var publicMethods = instanceDefinition.GetInstanceMethods().GetPublicSubsection().GetPublicMethods().AsArray()
+	var method = publicMethods[3].GetMethod()
+	var abstraction = method.GetOptionalResult().GetAny().(ast.AbstractionLike)
+	var prefixes = abstraction.GetPrefixes().AsArray()
+	ass.Equal(t, 2, len(prefixes))
+	var _, isMap = prefixes[0].GetAny().(ast.MapLike)
+	ass.True(t, isMap)
+	var _, isPointer = prefixes[1].GetAny().(ast.PointerLike)
+	ass.True(t, isPointer)
+}
+
+func TestInlineFunctions(t *tes.T) {
+	var source = fixture{
+		parameters: "[V any]",
+		constructors: `	Make(
+		predicate func(V) bool,
+		handlers abs.Sequential[func()],
+	) BetaLike[V]
+	MakeWithRanker(
+		ranker func(V, V) col.Rank,
+	) BetaLike[V]
+`,
+		methods: "\tGetClass() BetaClassLike[V]\n\tGetHandler() func(string)\n",
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// The argument of an inline function may only be a generic parameter of
+	// the class that declares it.
+	var undeclared = sts.Replace(source, "type BetaClassLike[V any] interface", "type BetaClassLike interface", 1)
+	model = parser.ParseSource(undeclared)
+	ass.PanicsWithValue(
+		t,
+		"The following type is not declared by the model: V",
+		func() { validator.ValidateModel(model) },
+	)
+
+	// An additional argument may only follow the first argument.
+	var invalid = sts.Replace(source, "func(string)", "func(, string)", 1)
+	ass.Panics(t, func() { parser.ParseSource(invalid) })
+
+	// The arguments of an inline function may not be named.
+	invalid = sts.Replace(source, "func(string)", "func(name string)", 1)
+	var message = func() (message any) {
+		defer func() { message = recover() }()
+		parser.ParseSource(invalid)
+		return message
+	}()
+	ass.Contains(t, message, "The arguments of an inline function may not be named.")
+}
+
+func TestDirectionalChannels(t *tes.T) {
+	var source = fixture{
+		constructors: `	Make(
+		input <-chan Event,
+		output chan<- Event,
+		done chan bool,
+	) BetaLike
+`,
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// A receive-only channel is not also send-only.
+	var invalid = sts.Replace(source, "<-chan Event", "<-chan<- Event", 1)
+	var _, err = parser.TryParseSource(invalid)
+	var syntaxError = err.(gra.SyntaxErrorLike)
+	ass.Equal(t, "Channel", syntaxError.GetOptionalRuleName())
+	ass.Equal(t, `"<-" "chan" | "chan" "<-"?`, syntaxError.GetOptionalDefinition())
+}
+
+func TestFixedSizeArrays(t *tes.T) {
+	var source = fixture{
+		definitions: `// Type Definitions
+
+/*
+Hash is a constrained type representing a fixed-size digest.
+*/
+type Hash [16]byte
+
+/*
+Table is a constrained type representing a generic table.
+*/
+type Table[V any] []V
+
+/*
+Tag is a constrained type representing a fixed-size label.
+*/
+type Tag [N]uint8`,
+		constructors: `	Make(
+		digest [16]byte,
+		labels [N]Tag,
+	) BetaLike
+`,
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+}
+
+func TestEnumerationValues(t *tes.T) {
+	var source = fixture{
+		definitions: `// Type Definitions
+
+/*
+Code is a constrained type representing a wire protocol code.
+*/
+type Code uint16
+
+const (
+	Ok Code = 200
+	Created
+	NotFound = 404
+	Teapot = NotFound + 14
+)
+
+/*
+Color is a constrained type representing a named color.
+*/
+type Color string
+
+const (
+	Red Color = "red"
+	Green Color = "green"
+	Blue Color = "blue"
+)
+
+/*
+Flag is a constrained type representing a set of bit flags.
+*/
+type Flag uint8
+
+const (
+	_ Flag = iota
+	Readable = 1 << iota
+	Writable
+	Everything = Readable | Writable
+	Nothing = ^Everything
+)
+
+/*
+Mask is a constrained type representing a set of masked bits.
+*/
+type Mask uint16
+
+const (
+	Low Mask = 0x1F
+	High = 0o740 | 0b1 << 12
+	Both = (Low | High) &^ 0x10
+	Half = Both / 2 % 0xFF ^ 1
+)
+
+/*
+Offset is a constrained type representing a signed step.
+*/
+type Offset int8
+
+const (
+	Backward Offset = -1
+	Still = 0
+	Forward = -Backward
+	Limit = 1 - -Backward << 2
+	Ahead = +Forward
+)
+
+/*
+Ratio is a constrained type representing a fraction.
+*/
+type Ratio float64
+
+const (
+	Half Ratio = 0.5
+	Whole = 1_000.0e-3
+	Huge = 0x_FFFF_FFFF * 1E+6
+)
+
+/*
+Separator is a constrained type representing a separator character.
+*/
+type Separator rune
+
+const (
+	Tab Separator = '\t'
+	Comma Separator = ','
+	Space Separator = '\u00A0'
+	Quote Separator = '\''
+)`,
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// An additional value may declare its own type.
+	var typeSection = model.GetPrimitiveDefinitions().GetOptionalTypeSection()
+	var typeDefinition = typeSection.GetTypeDefinitions().AsArray()[1]
+	var enumeration = typeDefinition.GetOptionalEnumeration()
+	var green = enumeration.GetAdditionalValues().AsArray()[0]
+	ass.Equal(t, "Green", green.GetName())
+	ass.Equal(t, "Color", green.GetOptionalAbstraction().GetName())
+
+	// A unary operator may complement its operand.
+	typeDefinition = typeSection.GetTypeDefinitions().AsArray()[2]
+	enumeration = typeDefinition.GetOptionalEnumeration()
+	var nothing = enumeration.GetAdditionalValues().AsArray()[3]
+	var expression = nothing.GetOptionalInitializer().GetExpression()
+	var unary = expression.GetOperand().GetAny().(ast.UnaryLike)
+	ass.Equal(t, "^", unary.GetOperator())
+	ass.Equal(t, "Everything", unary.GetOperand().GetAny().(ast.ReferenceLike).GetName())
+
+	// A value that declares its type must also declare its initial value.
+	var invalid = sts.Replace(source, `Blue Color = "blue"`, "Blue Color", 1)
+	var _, err = parser.TryParseSource(invalid)
+	var syntaxError = err.(gra.SyntaxErrorLike)
+	ass.Equal(t, "AdditionalValue", syntaxError.GetOptionalRuleName())
+
+	// A rune literal must contain a single character.
+	invalid = sts.Replace(source, "','", "',,'", 1)
+	_, err = parser.TryParseSource(invalid)
+	syntaxError = err.(gra.SyntaxErrorLike)
+	ass.Equal(t, gra.ErrorToken, syntaxError.GetOptionalToken().GetType())
+}
+
+func TestConstraintUnions(t *tes.T) {
+	var source = fixture{
+		parameters: "[N ~int | ~float64, K ~string | ~[]byte]",
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// A syntax error within a constraint is reported where it occurs.
+	var invalid = sts.Replace(source, "[N ~int | ~float64,", "[N ,", 1)
+	var _, err = parser.TryParseSource(invalid)
+	var syntaxError = err.(gra.SyntaxErrorLike)
+	ass.Equal(t, "Constraint", syntaxError.GetOptionalRuleName())
+	ass.Equal(t, uint(15), syntaxError.GetLine())
+	ass.Equal(t, uint(22), syntaxError.GetPosition())
+}
+
+func TestMethodNotes(t *tes.T) {
+	var source = fixture{
+		constructors: "\t// Make returns a new beta.\n\tMake() BetaLike\n",
+		methods: `	// GetClass returns the class of this beta.  It is
+	// the same for every instance.
+	GetClass() BetaClassLike
+	DoSomething()
+
+	// Attribute Methods
+	// GetName returns the name.
+	GetName() string
+	// SetName changes the name.
+	SetName(
+		name string,
+	)
+`,
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// The note is stored with the method that it documents.
+	var classSection = model.GetInterfaceDefinitions().GetClassSection()
+	var classDefinition = classSection.GetClassDefinitions().AsArray()[0]
+	var classMethods = classDefinition.GetClassMethods()
+	var constructorSubsection = classMethods.GetConstructorSubsection()
+	var constructorMethod = constructorSubsection.GetConstructorMethods().AsArray()[0]
+	ass.Equal(t, "// Make returns a new beta.", constructorMethod.GetOptionalNote())
+}
+
+func TestTupleResults(t *tes.T) {
+	var source = fixture{
+		definitions: `// Type Definitions
+
+/*
+Sequence[V any] is a constrained type representing a generic sequence.
+*/
+type Sequence[V any] []V
+
+/*
+Tag is a constrained type representing a label.
+*/
+type Tag string
+
+/*
+ValueLike is a constrained type representing any value.
+*/
+type ValueLike any`,
+		parameters:   "[V any]",
+		constructors: "\tMake() BetaLike[V]\n",
+		methods: `	GetClass() BetaClassLike[V]
+	Lookup(
+		key string,
+	) (ValueLike, error)
+	GetBytes() ([]byte, bool)
+	GetTags() (Sequence[Tag], error)
+	Split() (
+		values []V,
+		ok bool,
+	)
+	GetHandler() func() (int, error)
+	GetDigest() (
+		digest [N]byte,
+	)
+`,
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// A syntax error within a tuple is reported where it occurs.
+	var invalid = sts.Replace(source, "(ValueLike, error)", "(ValueLike, error", 1)
+	var _, err = parser.TryParseSource(invalid)
+	var syntaxError = err.(gra.SyntaxErrorLike)
+	ass.Equal(t, "Tuple", syntaxError.GetOptionalRuleName())
+	ass.Equal(t, uint(48), syntaxError.GetLine())
+
+	// A tuple may end with a trailing "," delimiter and span several lines.
+	var multiline = sts.Replace(source, "(ValueLike, error)", "(\n\t\tValueLike,\n\t\terror,\n\t)", 1)
+	model = parser.ParseSource(multiline)
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// A list of named outputs may also be written on a single line.
+	var named = sts.Replace(source, "(\n\t\tvalues []V,\n\t\tok bool,\n\t)", "(values []V, ok bool)", 1)
+	model = parser.ParseSource(named)
+	validator.ValidateModel(model)
+	ass.Equal(t, source, formatter.FormatModel(model))
+}
+
+func TestEmbeddedAspects(t *tes.T) {
+	var source = fixture{
+		methods: "\tGetClass() BetaClassLike\n\n\t// Aspect Methods\n\tIndexed[string]\n",
+		aspects: `// Aspect Definitions
+
+/*
+Indexed[V any] is an aspect interface.
+*/
+type Indexed[V any] interface {
+	Sequential[V]
+	col.Ordered
+	GetValue(
+		index int,
+	) V
+}
+
+/*
+Sequential[V any] is an aspect interface.
+*/
+type Sequential[V any] interface {
+	IsEmpty() bool
+}
+`,
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// An aspect must embed another aspect or define a method.
+	var invalid = sts.Replace(source, "\tIsEmpty() bool\n", "", 1)
+	var _, err = parser.TryParseSource(invalid)
+	var syntaxError = err.(gra.SyntaxErrorLike)
+	ass.Equal(t, "AspectDefinition", syntaxError.GetOptionalRuleName())
+}
+
+func TestImportForms(t *tes.T) {
+	var source = fixture{
+		imports: "\tfmt \"fmt\"\n\t\"github.com/example/go-things/v2\"\n\t. \"github.com/example/shapes\"\n\t_ \"embed\"\n",
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// Only an import alias or an enumeration value may be a blank "_".
+	var invalid = sts.Replace(source, "Make() BetaLike", "Make(\n\t\t_ string,\n\t) BetaLike", 1)
+	var _, err = parser.TryParseSource(invalid)
+	var syntaxError = err.(gra.SyntaxErrorLike)
+	ass.Equal(t, "_", syntaxError.GetOptionalToken().GetValue())
+	ass.Equal(t, gra.DelimiterToken, syntaxError.GetOptionalToken().GetType())
+}
+
+func TestConstantDefinitions(t *tes.T) {
+	var source = fixture{
+		definitions: `// Constant Definitions
+
+/*
+MaxDepth is the maximum depth of a nested model.
+*/
+const MaxDepth int = 1 << 5
+
+/*
+ErrNotFound is returned when a model cannot be found.
+*/
+var ErrNotFound = errors.New("not found")
+
+/*
+DefaultName is the name of an unnamed model.
+*/
+var DefaultName = fmt.Sprintf("%s-%d", Prefix, MaxDepth + 1)
+
+/*
+Greeting is the quoted greeting for the default model.
+*/
+var Greeting = fmt.Sprintf("say \"%s\"\n", DefaultName)`,
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// Only exported constants may be defined.
+	source = sts.Replace(source, "MaxDepth int", "maxDepth int", 1)
+	model = parser.ParseSource(source)
+	ass.Panics(t, func() { validator.ValidateModel(model) })
+
+	// A unary operator must be followed by an operand.
+	source = sts.Replace(source, "1 << 5", "1 << -", 1)
+	ass.Panics(t, func() { parser.ParseSource(source) })
+}
+
+func TestStructureDefinitions(t *tes.T) {
+	var source = fixture{
+		definitions: `// Type Definitions
+
+/*
+Options is a structure containing the options for formatting a model.
+*/
+type Options struct {
+	Name     string ` + "`json:\"name\"`" + `
+	MaxDepth uint   ` + "`json:\"maxDepth,omitempty\"`" + `
+	Handler  func(string) error
+	Modules  map[string]col.ListLike[string]
+	Strict   bool ` + "`json:\"strict\"`" + `
+}`,
+	}.source()
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var validator = gra.Validator().Make()
+	validator.ValidateModel(model)
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, source, formatter.FormatModel(model))
+
+	// A structure may not define the same field more than once.
+	var duplicate = sts.Replace(source, "Handler ", "Name    ", 1)
+	model = parser.ParseSource(duplicate)
+	ass.Panics(t, func() { validator.ValidateModel(model) })
+
+	// A tag must fit on a single line.
+	var multiline = sts.Replace(source, "`json:\"strict\"`", "`json:\n\"strict\"`", 1)
+	var _, err = parser.TryParseSource(multiline)
+	var syntaxError = err.(gra.SyntaxErrorLike)
+	ass.Equal(t, gra.ErrorToken, syntaxError.GetOptionalToken().GetType())
+	ass.Equal(t, "`", syntaxError.GetOptionalToken().GetValue())
+}
+
+func TestScanningOnDemand(t *tes.T) {
+	var scanner = gra.Scanner().MakeWithSource("package example\n")
+	var token = scanner.ScanToken()
+	ass.Equal(t, gra.DelimiterToken, token.GetType())
+	ass.Equal(t, "package", token.GetValue())
+	token = scanner.ScanToken()
+	ass.Equal(t, gra.SpaceToken, token.GetType())
+	token = scanner.ScanToken()
+	ass.Equal(t, gra.NameToken, token.GetType())
+	ass.Equal(t, uint(1), token.GetLine())
+	ass.Equal(t, uint(9), token.GetPosition())
+	token = scanner.ScanToken()
+	ass.Equal(t, gra.NewlineToken, token.GetType())
+	ass.Nil(t, scanner.ScanToken())
+
+	// No tokens are scanned past an unrecognized character.
+	scanner = gra.Scanner().MakeWithSource("package $example")
+	scanner.ScanToken()
+	scanner.ScanToken()
+	token = scanner.ScanToken()
+	ass.Equal(t, gra.ErrorToken, token.GetType())
+	ass.Nil(t, scanner.ScanToken())
+
+	// A failed parse must not leave a scanner goroutine behind.
+	var parser = gra.Parser().Make()
+	var before = run.NumGoroutine()
+	for range 100 {
+		var _, err = parser.TryParseSource(badSource)
+		ass.NotNil(t, err)
+	}
+	ass.Equal(t, before, run.NumGoroutine())
+}
+
+func benchmarkScanning(b *tes.B, copies int) {
+	// Scanning should take time proportional to the size of the source.
+	var bytes, err = osx.ReadFile("../ast/Package.go")
+	if err != nil {
+		panic(err)
+	}
+	var source = sts.Repeat(string(bytes), copies)
+	b.SetBytes(int64(len(source)))
+	b.ResetTimer()
+	for range b.N {
+		var scanner = gra.Scanner().MakeWithSource(source)
+		for token := scanner.ScanToken(); token != nil; {
+			token = scanner.ScanToken()
+		}
+	}
+}
+
+func BenchmarkScanning1x(b *tes.B) {
+	benchmarkScanning(b, 1)
+}
+
+func BenchmarkScanning4x(b *tes.B) {
+	benchmarkScanning(b, 4)
+}
+
+func BenchmarkScanning16x(b *tes.B) {
+	benchmarkScanning(b, 16)
+}
+
+func TestScanningTokens(t *tes.T) {
+	var source = "package example\n\n\tvar π = 3\n"
+	var tokens = gra.Scanner().ScanTokens(source)
+	ass.Equal(t, 14, tokens.GetSize())
+
+	// The tokens are a lossless representation of the source.
+	var builder sts.Builder
+	var iterator = tokens.GetIterator()
+	for iterator.HasNext() {
+		var token = iterator.GetNext()
+		var offset = int(token.GetOffset())
+		var value = token.GetValue()
+		ass.Equal(t, source[offset:offset+len(value)], value)
+		builder.WriteString(value)
+	}
+	ass.Equal(t, source, builder.String())
+
+	// Each token knows where it was found in the source.
+	var token = tokens.AsArray()[8]
+	ass.Equal(t, gra.NameToken, token.GetType())
+	ass.Equal(t, "π", token.GetValue())
+	ass.Equal(t, uint(3), token.GetLine())
+	ass.Equal(t, uint(9), token.GetPosition())
+	ass.Equal(t, uint(22), token.GetOffset())
+	token = tokens.AsArray()[5]
+	ass.Equal(t, "\t", token.GetValue())
+	ass.Equal(
+		t,
+		`Token [type: space, line: 3, position: 1]: "<HTAB>"`,
+		gra.Scanner().FormatToken(token),
+	)
+
+	// The tokens end with an error token at an unrecognized character.
+	tokens = gra.Scanner().ScanTokens("package $example")
+	ass.Equal(t, 3, tokens.GetSize())
+	ass.Equal(t, gra.ErrorToken, tokens.AsArray()[2].GetType())
+	ass.True(t, gra.Scanner().ScanTokens("").IsEmpty())
+}
+
+func TestScanningNotes(t *tes.T) {
+	// A note may span consecutive comment lines indented by spaces or tabs.
+	var source = "// The first line.\n\t\t// The second line.\n\tName"
+	var tokens = gra.Scanner().ScanTokens(source).AsArray()
+	ass.Equal(t, gra.NoteToken, tokens[0].GetType())
+	ass.Equal(t, "// The first line.\n\t\t// The second line.", tokens[0].GetValue())
+
+	// A blank line separates two notes.
+	source = "// The first note.\n\n\t// The second note.\n"
+	tokens = gra.Scanner().ScanTokens(source).AsArray()
+	ass.Equal(t, gra.NoteToken, tokens[0].GetType())
+	ass.Equal(t, "// The first note.", tokens[0].GetValue())
+	ass.Equal(t, gra.NoteToken, tokens[4].GetType())
+	ass.Equal(t, "// The second note.", tokens[4].GetValue())
+
+	// A note may begin with the text of a reserved comment.
+	source = "// Constant Definitions are defined elsewhere.\n"
+	tokens = gra.Scanner().ScanTokens(source).AsArray()
+	ass.Equal(t, gra.NoteToken, tokens[0].GetType())
+	ass.Equal(t, "// Constant Definitions are defined elsewhere.", tokens[0].GetValue())
+
+	// A reserved comment must make up the rest of its line.
+	source = "// Constant Definitions\n"
+	tokens = gra.Scanner().ScanTokens(source).AsArray()
+	ass.Equal(t, gra.DelimiterToken, tokens[0].GetType())
+	ass.Equal(t, "// Constant Definitions", tokens[0].GetValue())
+}
+
+func TestParsingDirectories(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var workspace = parser.ParseDirectory("..")
+	var modulePath = "github.com/craterdog/go-model-framework/v4"
+	ass.Equal(t, modulePath, workspace.GetModulePath())
+	ass.Equal(
+		t,
+		[]string{
+			modulePath + "/ast",
+			modulePath + "/generator",
+			modulePath + "/grammar",
+		},
+		workspace.GetModels().GetKeys().AsArray(),
+	)
+	var model = workspace.GetModel(modulePath + "/grammar")
+	var header = model.GetModuleDefinition().GetHeader()
+	ass.Equal(t, "grammar", header.GetName())
+
+	// A subdirectory is keyed by its import path within the module.
+	workspace = parser.ParseDirectory("../ast")
+	ass.Equal(t, 1, workspace.GetModels().GetSize())
+	ass.NotNil(t, workspace.GetModel(modulePath+"/ast"))
+
+	// A model file containing a syntax error is reported.
+	var directory = t.TempDir()
+	var err = osx.WriteFile(directory+"/go.mod", []byte("module example.com/bad\n"), 0644)
+	ass.Nil(t, err)
+	err = osx.WriteFile(directory+"/Package.go", []byte("package bad\n"), 0644)
+	ass.Nil(t, err)
+	ass.Panics(t, func() { parser.ParseDirectory(directory) })
+
+	// Every model file containing a syntax error is reported.
+	err = osx.Mkdir(directory+"/worse", 0755)
+	ass.Nil(t, err)
+	err = osx.WriteFile(directory+"/worse/Package.go", []byte("package worse\n"), 0644)
+	ass.Nil(t, err)
+	workspace, err = parser.TryParseDirectory(directory)
+	ass.Nil(t, workspace)
+	ass.NotNil(t, err)
+	var failures = err.(interface{ Unwrap() []error }).Unwrap()
+	ass.Equal(t, 2, len(failures))
+	ass.True(t, sts.HasPrefix(failures[0].Error(), fil.Join(directory, "Package.go")+": Line 1"))
+	ass.True(t, sts.HasPrefix(failures[1].Error(), fil.Join(directory, "worse", "Package.go")+": Line 1"))
+	var syntaxError gra.SyntaxErrorLike
+	ass.True(t, ers.As(err, &syntaxError))
+
+	// The packages of a nested module are not part of the module.
+	err = osx.Mkdir(directory+"/nested", 0755)
+	ass.Nil(t, err)
+	err = osx.WriteFile(directory+"/nested/go.mod", []byte("module example.com/nested\n"), 0644)
+	ass.Nil(t, err)
+	err = osx.WriteFile(directory+"/nested/Package.go", []byte("package nested\n"), 0644)
+	ass.Nil(t, err)
+	_, err = parser.TryParseDirectory(directory)
+	failures = err.(interface{ Unwrap() []error }).Unwrap()
+	ass.Equal(t, 2, len(failures))
+
+	// A valid directory tree is parsed without an error.
+	workspace, err = parser.TryParseDirectory("../ast")
+	ass.Nil(t, err)
+	ass.Equal(t, 1, workspace.GetModels().GetSize())
+
+	// A directory tree that is not in a Go module is reported.
+	_, err = parser.TryParseDirectory(t.TempDir())
+	ass.NotNil(t, err)
+}
+
+func TestResolvingWorkspaces(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var workspace = parser.ParseDirectory("..")
+	var resolver = gra.Resolver().Make(workspace)
+	var errors = resolver.ResolveWorkspace()
+	ass.True(t, errors.IsEmpty())
+
+	// Create a workspace containing a package that references another package.
+	var directory = t.TempDir()
+	var err = osx.WriteFile(directory+"/go.mod", []byte("module example.com/demo\n"), 0644)
+	ass.Nil(t, err)
+	err = osx.Mkdir(directory+"/alpha", 0755)
+	ass.Nil(t, err)
+	var source = fixture{packageName: "alpha"}.source()
+	err = osx.WriteFile(directory+"/alpha/Package.go", []byte(source), 0644)
+	ass.Nil(t, err)
+	err = osx.Mkdir(directory+"/gamma", 0755)
+	ass.Nil(t, err)
+	source = fixture{
+		packageName: "gamma",
+		imports:     "\tfmt \"fmt\"\n\talp \"example.com/demo/alpha\"\n",
+		methods:     "\tGetClass() BetaClassLike\n\tGetAlpha() alp.BetaLike\n\tGetMissing() alp.MissingLike\n\tGetString() fmt.Stringer\n\tGetUnknown() unk.ThingLike\n",
+	}.source()
+	err = osx.WriteFile(directory+"/gamma/Package.go", []byte(source), 0644)
+	ass.Nil(t, err)
+
+	// Resolve the references between the packages.
+	workspace = parser.ParseDirectory(directory)
+	resolver = gra.Resolver().Make(workspace)
+	errors = resolver.ResolveWorkspace()
+	ass.Equal(
+		t,
+		[]string{
+			`example.com/demo/gamma, line 34, position 18: The type "alp.MissingLike" is not declared by the imported package "example.com/demo/alpha".`,
+			`example.com/demo/gamma, line 36, position 18: The module "unk" referenced by the type "unk.ThingLike" is not imported.`,
+		},
+		[]string{
+			errors.AsArray()[0].Error(),
+			errors.AsArray()[1].Error(),
+		},
+	)
+	var model = workspace.GetModel("example.com/demo/gamma")
+	var instanceSection = model.GetInterfaceDefinitions().GetInstanceSection()
+	var instanceDefinition = instanceSection.GetInstanceDefinitions().AsArray()[0]
+	var publicMethods = instanceDefinition.GetInstanceMethods().GetPublicSubsection().GetPublicMethods().AsArray()
+	var method = publicMethods[1].GetMethod()
+	var abstraction = method.GetOptionalResult().GetAny().(ast.AbstractionLike)
+	var declaration = resolver.GetDeclaration(abstraction)
+	ass.Equal(t, "BetaLike", declaration.GetName())
+	method = publicMethods[3].GetMethod()
+	abstraction = method.GetOptionalResult().GetAny().(ast.AbstractionLike)
+	ass.Nil(t, resolver.GetDeclaration(abstraction))
+
+	// Resolving the workspace again starts over.
+	ass.Equal(t, 2, resolver.ResolveWorkspace().GetSize())
+	method = publicMethods[1].GetMethod()
+	abstraction = method.GetOptionalResult().GetAny().(ast.AbstractionLike)
+	ass.Equal(t, declaration, resolver.GetDeclaration(abstraction))
+
+	// A module without a known name may provide any unresolved module name.
+	source = sts.Replace(
+		source,
+		"\talp \"example.com/demo/alpha\"\n",
+		"\t\"example.com/demo/alpha\"\n\t\"example.com/other/go-things\"\n",
+		1,
+	)
+	source = sts.ReplaceAll(source, "alp.", "alpha.")
+	err = osx.WriteFile(directory+"/gamma/Package.go", []byte(source), 0644)
+	ass.Nil(t, err)
+	workspace = parser.ParseDirectory(directory)
+	resolver = gra.Resolver().Make(workspace)
+	errors = resolver.ResolveWorkspace()
+	ass.Equal(t, 1, errors.GetSize())
+	ass.Equal(t, "example.com/demo/alpha", errors.AsArray()[0].GetOptionalModulePath())
+
+	// A blank or dot import never provides a module name.
+	source = fixture{
+		packageName: "gamma",
+		imports:     "\t_ \"embed\"\n\t. \"example.com/demo/alpha\"\n",
+		methods:     "\tGetClass() BetaClassLike\n\tGetUnknown() unk.ThingLike\n",
+	}.source()
+	err = osx.WriteFile(directory+"/gamma/Package.go", []byte(source), 0644)
+	ass.Nil(t, err)
+	workspace = parser.ParseDirectory(directory)
+	resolver = gra.Resolver().Make(workspace)
+	errors = resolver.ResolveWorkspace()
+	ass.Equal(t, 1, errors.GetSize())
+	ass.Equal(
+		t,
+		`example.com/demo/gamma, line 33, position 18: The module "unk" referenced by the type "unk.ThingLike" is not imported.`,
+		errors.AsArray()[0].Error(),
+	)
+}
+
+func TestModuleNames(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var workspace = parser.ParseDirectory("..")
+	var modules = fixture{
+		imports: "\tfmt \"fmt\"\n\t\"math/rand/v2\"\n\t\"github.com/craterdog/go-model-framework/v4/ast\"\n\t\"github.com/example/go-things/v2\"\n\t. \"github.com/example/shapes\"\n",
+	}.source()
+	var model = parser.ParseSource(modules)
+	var imports = model.GetModuleDefinition().GetOptionalImports()
+	var names []string
+	var iterator = imports.GetModules().GetIterator()
+	for iterator.HasNext() {
+		var module = iterator.GetNext()
+		names = append(names, gra.Workspace().ModuleName(module, workspace))
+	}
+	ass.Equal(t, []string{"fmt", "rand", "ast", "", "."}, names)
+}
+
+const genericDefinitions = `// Functional Definitions
+
+/*
+RankingFunction[V any] is a functional type.
+*/
+type RankingFunction[V any] func(
+	first V,
+	second V,
+) int
+
+// Constant Definitions
+
+/*
+MaxDepth is the maximum depth of a nested model.
+*/
+const MaxDepth int = 1 << 5`
+
+func TestSymbolTables(t *tes.T) {
+	var bytes, err = osx.ReadFile("../grammar/Package.go")
+	ass.Nil(t, err)
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(string(bytes))
+	var symbols = gra.SymbolTable().Make(model)
+	ass.Equal(t, gra.TypeSymbol, symbols.GetKind("TokenType"))
+	ass.Equal(t, gra.ValueSymbol, symbols.GetKind("ErrorToken"))
+	ass.Equal(t, gra.ClassSymbol, symbols.GetKind("ParserClassLike"))
+	ass.Equal(t, gra.InstanceSymbol, symbols.GetKind("ParserLike"))
+	ass.Equal(t, gra.AspectSymbol, symbols.GetKind("Methodical"))
+	ass.Equal(t, gra.UnknownSymbol, symbols.GetKind("MissingLike"))
+	ass.Nil(t, symbols.LookupSymbol("MissingLike"))
+
+	// An enumeration value is declared by the type that defines it.
+	var symbol = symbols.LookupSymbol("TagToken")
+	ass.Equal(t, "TagToken", symbol.GetName())
+	ass.Equal(t, "TokenType", symbol.GetOptionalDeclaration().GetName())
+	var _, isValue = symbol.GetDefinition().(ast.AdditionalValueLike)
+	ass.True(t, isValue)
+	var classes = symbols.GetSymbols(gra.ClassSymbol)
+	var instances = symbols.GetSymbols(gra.InstanceSymbol)
+	ass.Equal(t, classes.GetSize(), instances.GetSize())
+	ass.Equal(t, gra.ClassSymbol, classes.AsArray()[0].GetKind())
+
+	// Generic parameters are scoped to the declaration that defines them.
+	var source = fixture{
+		definitions: genericDefinitions,
+		aspects: `// Aspect Definitions
+
+/*
+Sequential[V any] is an aspect interface.
+*/
+type Sequential[V any] interface {
+	IsEmpty() bool
+}
+`,
+	}.source()
+	model = parser.ParseSource(source)
+	symbols = gra.SymbolTable().Make(model)
+	ass.Equal(t, gra.FunctionalSymbol, symbols.GetKind("RankingFunction"))
+	ass.Equal(t, gra.UnknownSymbol, symbols.GetKind("V"))
+	var parameters = symbols.LookupSymbols("V").AsArray()
+	ass.Equal(t, 2, len(parameters))
+	ass.Equal(t, gra.ParameterSymbol, parameters[0].GetKind())
+	ass.Equal(t, "RankingFunction", parameters[0].GetOptionalDeclaration().GetName())
+	ass.Equal(t, "Sequential", parameters[1].GetOptionalDeclaration().GetName())
+	ass.Equal(t, 2, symbols.GetSymbols(gra.ParameterSymbol).GetSize())
+	var declaration = parameters[1].GetOptionalDeclaration()
+	ass.Equal(t, parameters[1], symbols.LookupParameter(declaration, "V"))
+	ass.Nil(t, symbols.LookupParameter(declaration, "T"))
+	declaration = symbols.LookupSymbol("BetaLike").GetOptionalDeclaration()
+	ass.Nil(t, symbols.LookupParameter(declaration, "V"))
+
+	// A constant definition is not declared by a type.
+	symbol = symbols.LookupSymbol("MaxDepth")
+	ass.Equal(t, gra.ConstantSymbol, symbol.GetKind())
+	ass.Nil(t, symbol.GetOptionalDeclaration())
+	var _, isConstant = symbol.GetDefinition().(ast.ConstantDefinitionLike)
+	ass.True(t, isConstant)
+
+	// Only the names of types may be used as types.
+	ass.Nil(t, symbols.LookupType("MaxDepth"))
+	ass.Equal(t, gra.InstanceSymbol, symbols.LookupType("BetaLike").GetKind())
+	ass.True(t, gra.SymbolTable().IsPredeclared("string"))
+	ass.False(t, gra.SymbolTable().IsPredeclared("BetaLike"))
+	ass.True(t, symbols.IsDeclared("string", nil))
+	ass.True(t, symbols.IsDeclared("BetaLike", nil))
+	ass.False(t, symbols.IsDeclared("MaxDepth", nil))
+	declaration = parameters[1].GetOptionalDeclaration()
+	ass.True(t, symbols.IsDeclared("V", declaration))
+	ass.False(t, symbols.IsDeclared("V", nil))
+}
+
+func TestCrossReferences(t *tes.T) {
+	var bytes, err = osx.ReadFile("../grammar/Package.go")
+	ass.Nil(t, err)
+	var source = string(bytes)
+	var parser = gra.Parser().Make()
+	var model = parser.ParseSource(source)
+	var references = gra.CrossReference().Make(model)
+	ass.Equal(
+		t,
+		sts.Count(source, "ast.ModelLike"),
+		int(references.GetUsages("ast.ModelLike").GetSize()),
+	)
+	ass.True(t, references.GetUsages("MissingLike").IsEmpty())
+
+	// Each usage knows its enclosing declaration and method.
+	source = fixture{
+		methods: `	GetClass() BetaClassLike
+	GetModel() ast.ModelLike
+
+	// Attribute Methods
+	SetNames(
+		names abs.ListLike[ast.ModelLike],
+	)
+`,
+	}.source()
+	model = parser.ParseSource(source)
+	references = gra.CrossReference().Make(model)
+	ass.Equal(
+		t,
+		[]string{"BetaClassLike", "BetaLike", "abs.ListLike", "ast.ModelLike"},
+		references.GetTypeNames().AsArray(),
+	)
+	var usages = references.GetUsages("ast.ModelLike").AsArray()
+	ass.Equal(t, 2, len(usages))
+	ass.Equal(t, "BetaLike", usages[0].GetOptionalDeclaration().GetName())
+	ass.Equal(t, "GetModel", usages[0].GetOptionalMethod())
+	ass.Equal(t, uint(28), usages[0].GetSpan().GetStart().GetLine())
+	ass.Equal(t, uint(16), usages[0].GetSpan().GetStart().GetColumn())
+	ass.Equal(t, "SetNames", usages[1].GetOptionalMethod())
+	usages = references.GetUsages("BetaLike").AsArray()
+	ass.Equal(t, "BetaClassLike", usages[0].GetOptionalDeclaration().GetName())
+	ass.Equal(t, "Make", usages[0].GetOptionalMethod())
+
+	// An inline function type is indexed by its argument and result types.
+	source = fixture{
+		constructors: "\tMake(\n\t\tpredicate func(string) bool,\n\t) BetaLike\n",
+	}.source()
+	model = parser.ParseSource(source)
+	references = gra.CrossReference().Make(model)
+	ass.Equal(
+		t,
+		[]string{"BetaClassLike", "BetaLike", "bool", "string"},
+		references.GetTypeNames().AsArray(),
+	)
+	ass.True(t, references.GetUsages("").IsEmpty())
+	usages = references.GetUsages("string").AsArray()
+	ass.Equal(t, "Make", usages[0].GetOptionalMethod())
+}
+
+func TestUndeclaredTypes(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var validator = gra.Validator().Make()
+	var source = fixture{
+		methods: "\tGetClass() BetaClassLike\n\tGetFoo() FooLike\n",
+	}.source()
+	var model = parser.ParseSource(source)
+	ass.PanicsWithValue(
+		t,
+		"The following type is not declared by the model: FooLike",
+		func() { validator.ValidateModel(model) },
+	)
+
+	// A type provided by a dot import cannot be checked.
+	source = fixture{
+		imports: "\t. \"github.com/example/foo\"\n",
+		methods: "\tGetClass() BetaClassLike\n\tGetFoo() FooLike\n",
+	}.source()
+	model = parser.ParseSource(source)
+	validator.ValidateModel(model)
+
+	// A generic parameter is only declared within its own declaration.
+	source = fixture{definitions: genericDefinitions}.source()
+	model = parser.ParseSource(source)
+	validator.ValidateModel(model)
+	source = fixture{
+		definitions: genericDefinitions,
+		methods:     "\tGetClass() BetaClassLike\n\tGetValue() V\n",
+	}.source()
+	model = parser.ParseSource(source)
+	ass.PanicsWithValue(
+		t,
+		"The following type is not declared by the model: V",
+		func() { validator.ValidateModel(model) },
+	)
+
+	// The key type of a map must also be declared.
+	source = fixture{
+		methods: "\tGetClass() BetaClassLike\n\tGetEntries() map[KeyLike]string\n",
+	}.source()
+	model = parser.ParseSource(source)
+	ass.PanicsWithValue(
+		t,
+		"The following type is not declared by the model: KeyLike",
+		func() { validator.ValidateModel(model) },
+	)
+	model = parser.ParseSource(sts.Replace(source, "KeyLike", "string", 1))
+	validator.ValidateModel(model)
+}
@@ -0,0 +1,305 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package grammar
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+	ast "github.com/craterdog/go-model-framework/v4/ast"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func CrossReference() CrossReferenceClassLike {
+	return crossReferenceReference()
+}
+
+// Constructor Methods
+
+func (c *crossReferenceClass_) Make(
+	model ast.ModelLike,
+) CrossReferenceLike {
+	if uti.IsUndefined(model) {
+		panic("The \"model\" attribute is required by this class.")
+	}
+	var instance = &crossReference_{
+		// Initialize the instance attributes.
+		model_:  model,
+		usages_: col.Catalog[string, abs.ListLike[UsageLike]](),
+
+		// Initialize the inherited aspects.
+		Methodical: Processor().Make(),
+	}
+	instance.visitor_ = Visitor().Make(instance)
+	instance.visitor_.VisitModel(model)
+	instance.usages_.SortValues()
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *crossReference_) GetModel() ast.ModelLike {
+	return v.model_
+}
+
+// Methodical Methods
+
+func (v *crossReference_) PreprocessAbstraction(
+	abstraction ast.AbstractionLike,
+) {
+	if uti.IsDefined(abstraction.GetOptionalFunction()) {
+		// An inline function has no name but its argument and result types
+		// are visited as abstractions of their own.
+		return
+	}
+	var typeName = abstraction.GetName()
+	var suffix = abstraction.GetOptionalSuffix()
+	if uti.IsDefined(suffix) {
+		typeName += "." + suffix.GetName()
+	}
+	var usages = v.usages_.GetValue(typeName)
+	if uti.IsUndefined(usages) {
+		usages = col.List[UsageLike]()
+		v.usages_.SetValue(typeName, usages)
+	}
+	var usage = Usage().Make(abstraction, v.declaration_, v.method_)
+	usages.AppendValue(usage)
+}
+
+func (v *crossReference_) PreprocessAspectDefinition(
+	aspectDefinition ast.AspectDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = aspectDefinition.GetDeclaration()
+}
+
+func (v *crossReference_) PostprocessAspectDefinition(
+	aspectDefinition ast.AspectDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = nil
+}
+
+func (v *crossReference_) PreprocessClassDefinition(
+	classDefinition ast.ClassDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = classDefinition.GetDeclaration()
+}
+
+func (v *crossReference_) PostprocessClassDefinition(
+	classDefinition ast.ClassDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = nil
+}
+
+func (v *crossReference_) PreprocessConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+	index uint,
+	size uint,
+) {
+	v.method_ = constantMethod.GetName()
+}
+
+func (v *crossReference_) PostprocessConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+	index uint,
+	size uint,
+) {
+	v.method_ = ""
+}
+
+func (v *crossReference_) PreprocessConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+	index uint,
+	size uint,
+) {
+	v.method_ = constructorMethod.GetName()
+}
+
+func (v *crossReference_) PostprocessConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+	index uint,
+	size uint,
+) {
+	v.method_ = ""
+}
+
+func (v *crossReference_) PreprocessFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+	index uint,
+	size uint,
+) {
+	v.method_ = functionMethod.GetName()
+}
+
+func (v *crossReference_) PostprocessFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+	index uint,
+	size uint,
+) {
+	v.method_ = ""
+}
+
+func (v *crossReference_) PreprocessFunctionalDefinition(
+	functionalDefinition ast.FunctionalDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = functionalDefinition.GetDeclaration()
+}
+
+func (v *crossReference_) PostprocessFunctionalDefinition(
+	functionalDefinition ast.FunctionalDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = nil
+}
+
+func (v *crossReference_) PreprocessGetterMethod(
+	getterMethod ast.GetterMethodLike,
+) {
+	v.method_ = getterMethod.GetName()
+}
+
+func (v *crossReference_) PostprocessGetterMethod(
+	getterMethod ast.GetterMethodLike,
+) {
+	v.method_ = ""
+}
+
+func (v *crossReference_) PreprocessInstanceDefinition(
+	instanceDefinition ast.InstanceDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = instanceDefinition.GetDeclaration()
+}
+
+func (v *crossReference_) PostprocessInstanceDefinition(
+	instanceDefinition ast.InstanceDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = nil
+}
+
+func (v *crossReference_) PreprocessMethod(
+	method ast.MethodLike,
+) {
+	v.method_ = method.GetName()
+}
+
+func (v *crossReference_) PostprocessMethod(
+	method ast.MethodLike,
+) {
+	v.method_ = ""
+}
+
+func (v *crossReference_) PreprocessSetterMethod(
+	setterMethod ast.SetterMethodLike,
+) {
+	v.method_ = setterMethod.GetName()
+}
+
+func (v *crossReference_) PostprocessSetterMethod(
+	setterMethod ast.SetterMethodLike,
+) {
+	v.method_ = ""
+}
+
+func (v *crossReference_) PreprocessTypeDefinition(
+	typeDefinition ast.TypeDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = typeDefinition.GetDeclaration()
+}
+
+func (v *crossReference_) PostprocessTypeDefinition(
+	typeDefinition ast.TypeDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = nil
+}
+
+// Public Methods
+
+func (v *crossReference_) GetClass() CrossReferenceClassLike {
+	return v.getClass()
+}
+
+func (v *crossReference_) GetTypeNames() abs.Sequential[string] {
+	var result_ = v.usages_.GetKeys()
+	return result_
+}
+
+func (v *crossReference_) GetUsages(
+	typeName string,
+) abs.Sequential[UsageLike] {
+	var result_ abs.Sequential[UsageLike] = v.usages_.GetValue(typeName)
+	if uti.IsUndefined(result_) {
+		result_ = col.List[UsageLike]()
+	}
+	return result_
+}
+
+// Private Methods
+
+func (v *crossReference_) getClass() *crossReferenceClass_ {
+	return crossReferenceReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type crossReference_ struct {
+	// Declare the instance attributes.
+	visitor_     VisitorLike
+	model_       ast.ModelLike
+	declaration_ ast.DeclarationLike // The enclosing declaration, if any.
+	method_      string              // The enclosing method, if any.
+	usages_      abs.CatalogLike[string, abs.ListLike[UsageLike]]
+
+	// Declare the inherited aspects.
+	Methodical
+}
+
+// Class Structure
+
+type crossReferenceClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func crossReferenceReference() *crossReferenceClass_ {
+	return crossReferenceReference_
+}
+
+var crossReferenceReference_ = &crossReferenceClass_{
+	// Initialize the class constants.
+}
@@ -13,8 +13,10 @@
 package grammar
 
 import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
 	ast "github.com/craterdog/go-model-framework/v4/ast"
 	sts "strings"
+	tab "text/tabwriter"
 )
 
 // CLASS INTERFACE
@@ -50,14 +52,36 @@ func (v *formatter_) ProcessName(name string) {
 	v.appendString(name)
 }
 
+func (v *formatter_) ProcessNote(note string) {
+	// Each line of a note is aligned with the method that it documents.
+	var lines = sts.Split(note, "\n")
+	for _, line := range lines {
+		v.appendString(sts.TrimSpace(line))
+		v.appendNewline()
+	}
+}
+
+func (v *formatter_) ProcessNumber(number string) {
+	v.appendString(number)
+}
+
 func (v *formatter_) ProcessPath(path string) {
 	v.appendString(path)
 }
 
+func (v *formatter_) ProcessRune(rune_ string) {
+	v.appendString(rune_)
+}
+
 func (v *formatter_) ProcessSpace(space string) {
 	v.appendString(space)
 }
 
+func (v *formatter_) ProcessTag(tag string) {
+	// A tab separates the tag column from the abstraction column.
+	v.appendString("\t" + tag)
+}
+
 func (v *formatter_) PreprocessAdditionalArgument(
 	additionalArgument ast.AdditionalArgumentLike,
 	index uint,
@@ -74,12 +98,52 @@ func (v *formatter_) PreprocessAdditionalConstraint(
 	v.appendString(", ")
 }
 
+func (v *formatter_) PreprocessAdditionalExpression(
+	additionalExpression ast.AdditionalExpressionLike,
+	index uint,
+	size uint,
+) {
+	v.appendString(", ")
+}
+
+func (v *formatter_) PreprocessAdditionalTerm(
+	additionalTerm ast.AdditionalTermLike,
+	index uint,
+	size uint,
+) {
+	v.appendString(" | ")
+}
+
 func (v *formatter_) PreprocessAdditionalValue(
 	additionalValue ast.AdditionalValueLike,
 	index uint,
 	size uint,
 ) {
 	v.appendNewline()
+	v.additionalValue_ = additionalValue
+	if additionalValue.GetName() == "_" {
+		v.appendString("_")
+	}
+}
+
+func (v *formatter_) ProcessAdditionalValueSlot(slot uint) {
+	switch slot {
+	case 1:
+		if uti.IsDefined(v.additionalValue_.GetOptionalAbstraction()) {
+			v.appendString(" ")
+		}
+	}
+}
+
+func (v *formatter_) PreprocessAlias(alias ast.AliasLike) {
+	switch alias.GetAny() {
+	case ".", "_":
+		v.appendString(alias.GetAny().(string))
+	}
+}
+
+func (v *formatter_) PostprocessAlias(alias ast.AliasLike) {
+	v.appendString(" ")
 }
 
 func (v *formatter_) PreprocessArguments(arguments ast.ArgumentsLike) {
@@ -167,7 +231,14 @@ func (v *formatter_) PreprocessAttributeSubsection(attributeSubsection ast.Attri
 }
 
 func (v *formatter_) PreprocessChannel(channel ast.ChannelLike) {
-	v.appendString("chan ")
+	switch channel.GetDirection() {
+	case ast.Receive:
+		v.appendString("<-chan ")
+	case ast.Send:
+		v.appendString("chan<- ")
+	default:
+		v.appendString("chan ")
+	}
 }
 
 func (v *formatter_) PreprocessClassDefinition(
@@ -203,6 +274,32 @@ func (v *formatter_) PreprocessClassSection(classSection ast.ClassSectionLike) {
 	v.appendNewline()
 }
 
+func (v *formatter_) PreprocessConstantDefinition(
+	constantDefinition ast.ConstantDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.appendNewline()
+	v.constantDefinition_ = constantDefinition
+}
+
+func (v *formatter_) ProcessConstantDefinitionSlot(slot uint) {
+	switch slot {
+	case 3:
+		if uti.IsDefined(v.constantDefinition_.GetOptionalAbstraction()) {
+			v.appendString(" ")
+		}
+	}
+}
+
+func (v *formatter_) PostprocessConstantDefinition(
+	constantDefinition ast.ConstantDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.appendNewline()
+}
+
 func (v *formatter_) PreprocessConstantMethod(
 	constantMethod ast.ConstantMethodLike,
 	index uint,
@@ -213,11 +310,17 @@ func (v *formatter_) PreprocessConstantMethod(
 
 func (v *formatter_) ProcessConstantMethodSlot(slot uint) {
 	switch slot {
-	case 1:
+	case 2:
 		v.appendString("() ")
 	}
 }
 
+func (v *formatter_) PreprocessConstantSection(constantSection ast.ConstantSectionLike) {
+	v.appendNewline()
+	v.appendString("// Constant Definitions")
+	v.appendNewline()
+}
+
 func (v *formatter_) PreprocessConstantSubsection(constantSubsection ast.ConstantSubsectionLike) {
 	v.appendString("\n")
 	v.appendNewline()
@@ -251,9 +354,9 @@ func (v *formatter_) PreprocessConstructorMethod(
 
 func (v *formatter_) ProcessConstructorMethodSlot(slot uint) {
 	switch slot {
-	case 1:
-		v.appendString("(")
 	case 2:
+		v.appendString("(")
+	case 3:
 		v.appendString(") ")
 	}
 }
@@ -283,6 +386,34 @@ func (v *formatter_) PostprocessEnumeration(enumeration ast.EnumerationLike) {
 	v.appendString(")")
 }
 
+func (v *formatter_) PreprocessField(
+	field ast.FieldLike,
+	index uint,
+	size uint,
+) {
+	// The indentation is added once the field columns have been aligned.
+	v.appendString("\n")
+}
+
+func (v *formatter_) ProcessFieldSlot(slot uint) {
+	switch slot {
+	case 1:
+		// A tab separates the abstraction column from the name column.
+		v.appendString("\t")
+	}
+}
+
+func (v *formatter_) PreprocessFunction(function ast.FunctionLike) {
+	v.appendString("func(")
+}
+
+func (v *formatter_) ProcessFunctionSlot(slot uint) {
+	switch slot {
+	case 2:
+		v.appendString(")")
+	}
+}
+
 func (v *formatter_) PreprocessFunctionMethod(
 	functionMethod ast.FunctionMethodLike,
 	index uint,
@@ -293,9 +424,9 @@ func (v *formatter_) PreprocessFunctionMethod(
 
 func (v *formatter_) ProcessFunctionMethodSlot(slot uint) {
 	switch slot {
-	case 1:
-		v.appendString("(")
 	case 2:
+		v.appendString("(")
+	case 3:
 		v.appendString(")")
 	}
 }
@@ -339,7 +470,7 @@ func (v *formatter_) PreprocessFunctionalSection(functionalSection ast.Functiona
 
 func (v *formatter_) ProcessGetterMethodSlot(slot uint) {
 	switch slot {
-	case 1:
+	case 2:
 		v.appendString("() ")
 	}
 }
@@ -361,6 +492,10 @@ func (v *formatter_) PostprocessImports(imports ast.ImportsLike) {
 	v.appendString(")")
 }
 
+func (v *formatter_) PreprocessInitializer(initializer ast.InitializerLike) {
+	v.appendString(" = ")
+}
+
 func (v *formatter_) PreprocessInstanceDefinition(
 	instanceDefinition ast.InstanceDefinitionLike,
 	index uint,
@@ -394,6 +529,14 @@ func (v *formatter_) PreprocessInstanceSection(instanceSection ast.InstanceSecti
 	v.appendNewline()
 }
 
+func (v *formatter_) PreprocessInvocation(invocation ast.InvocationLike) {
+	v.appendString("(")
+}
+
+func (v *formatter_) PostprocessInvocation(invocation ast.InvocationLike) {
+	v.appendString(")")
+}
+
 func (v *formatter_) PreprocessMap(map_ ast.MapLike) {
 	v.appendString("map[")
 }
@@ -410,9 +553,9 @@ func (v *formatter_) PreprocessMethod(
 
 func (v *formatter_) ProcessMethodSlot(slot uint) {
 	switch slot {
-	case 1:
-		v.appendString("(")
 	case 2:
+		v.appendString("(")
+	case 3:
 		v.appendString(")")
 	}
 }
@@ -425,10 +568,6 @@ func (v *formatter_) PreprocessModule(
 	v.appendNewline()
 }
 
-func (v *formatter_) ProcessModuleSlot(slot uint) {
-	v.appendString(" ")
-}
-
 func (v *formatter_) PostprocessModuleDefinition(moduleDefinition_ ast.ModuleDefinitionLike) {
 	v.appendNewline()
 }
@@ -437,6 +576,31 @@ func (v *formatter_) PostprocessNotice(notice ast.NoticeLike) {
 	v.appendNewline()
 }
 
+func (v *formatter_) PreprocessOperand(operand ast.OperandLike) {
+	if operand.GetAny() == "iota" {
+		v.appendString("iota")
+	}
+}
+
+func (v *formatter_) PreprocessOperator(operator ast.OperatorLike) {
+	v.appendString(" " + operator.GetAny().(string) + " ")
+}
+
+func (v *formatter_) PreprocessOutput(output ast.OutputLike) {
+	v.appendNewline()
+}
+
+func (v *formatter_) ProcessOutputSlot(slot uint) {
+	switch slot {
+	case 1:
+		v.appendString(" ")
+	}
+}
+
+func (v *formatter_) PostprocessOutput(output ast.OutputLike) {
+	v.appendString(",")
+}
+
 func (v *formatter_) PreprocessParameter(
 	parameter ast.ParameterLike,
 	index uint,
@@ -446,12 +610,16 @@ func (v *formatter_) PreprocessParameter(
 		v.depth_++
 	}
 	v.appendNewline()
+	v.parameter_ = parameter
 }
 
 func (v *formatter_) ProcessParameterSlot(slot uint) {
 	switch slot {
 	case 1:
 		v.appendString(" ")
+		if v.parameter_.IsVariadic() {
+			v.appendString("...")
+		}
 	}
 }
 
@@ -468,10 +636,26 @@ func (v *formatter_) PostprocessParameter(
 }
 
 func (v *formatter_) PreprocessParameterized(parameterized ast.ParameterizedLike) {
+	// A named result is always formatted with one output per line.
 	v.appendString("(")
+	v.depth_++
 }
 
 func (v *formatter_) PostprocessParameterized(parameterized ast.ParameterizedLike) {
+	v.depth_--
+	v.appendNewline()
+	v.appendString(")")
+}
+
+func (v *formatter_) PreprocessPointer(pointer ast.PointerLike) {
+	v.appendString("*")
+}
+
+func (v *formatter_) PreprocessPrecedence(precedence ast.PrecedenceLike) {
+	v.appendString("(")
+}
+
+func (v *formatter_) PostprocessPrecedence(precedence ast.PrecedenceLike) {
 	v.appendString(")")
 }
 
@@ -480,6 +664,10 @@ func (v *formatter_) PreprocessPublicSubsection(publicSubsection ast.PublicSubse
 	v.appendString("// Public Methods")
 }
 
+func (v *formatter_) PreprocessQualifier(qualifier ast.QualifierLike) {
+	v.appendString(qualifier.GetAny().(string) + " ")
+}
+
 func (v *formatter_) PreprocessResult(result ast.ResultLike) {
 	switch result.GetAny().(type) {
 	case ast.NoneLike:
@@ -490,7 +678,7 @@ func (v *formatter_) PreprocessResult(result ast.ResultLike) {
 
 func (v *formatter_) ProcessSetterMethodSlot(slot uint) {
 	switch slot {
-	case 1:
+	case 2:
 		v.appendString("(")
 	}
 }
@@ -499,10 +687,39 @@ func (v *formatter_) PostprocessSetterMethod(setterMethod ast.SetterMethodLike)
 	v.appendString(")")
 }
 
+func (v *formatter_) PreprocessStructure(structure ast.StructureLike) {
+	v.appendString("struct {")
+	v.depth_++
+	v.fields_ = v.result_.Len()
+}
+
+func (v *formatter_) PostprocessStructure(structure ast.StructureLike) {
+	var result = v.getResult()
+	v.appendString(result[:v.fields_])
+	v.appendString(v.alignFields(result[v.fields_:]))
+	v.depth_--
+	v.appendNewline()
+	v.appendString("}")
+}
+
 func (v *formatter_) PreprocessSuffix(suffix ast.SuffixLike) {
 	v.appendString(".")
 }
 
+func (v *formatter_) PreprocessTerm(term ast.TermLike) {
+	if term.IsApproximate() {
+		v.appendString("~")
+	}
+}
+
+func (v *formatter_) PreprocessTuple(tuple ast.TupleLike) {
+	v.appendString("(")
+}
+
+func (v *formatter_) PostprocessTuple(tuple ast.TupleLike) {
+	v.appendString(")")
+}
+
 func (v *formatter_) PreprocessTypeDefinition(
 	typeDefinition ast.TypeDefinitionLike,
 	index uint,
@@ -532,8 +749,15 @@ func (v *formatter_) PreprocessTypeSection(typeSection ast.TypeSectionLike) {
 	v.appendNewline()
 }
 
+func (v *formatter_) PreprocessUnary(unary ast.UnaryLike) {
+	v.appendString(unary.GetOperator())
+}
+
 func (v *formatter_) PreprocessValue(value ast.ValueLike) {
 	v.appendNewline()
+	if value.GetName() == "_" {
+		v.appendString("_")
+	}
 }
 
 func (v *formatter_) ProcessValueSlot(slot uint) {
@@ -543,10 +767,6 @@ func (v *formatter_) ProcessValueSlot(slot uint) {
 	}
 }
 
-func (v *formatter_) PostprocessValue(value ast.ValueLike) {
-	v.appendString(" = iota")
-}
-
 // Public Methods
 
 func (v *formatter_) GetClass() FormatterClassLike {
@@ -568,6 +788,16 @@ func (v *formatter_) getClass() *formatterClass_ {
 	return formatterReference()
 }
 
+func (v *formatter_) alignFields(fields string) string {
+	// Align the columns of the fields the same way that gofmt does.
+	var buffer sts.Builder
+	var writer = tab.NewWriter(&buffer, 0, 8, 1, ' ', 0)
+	writer.Write([]byte(fields))
+	writer.Flush()
+	var indentation = "\n" + sts.Repeat("\t", int(v.depth_))
+	return sts.ReplaceAll(buffer.String(), "\n", indentation)
+}
+
 func (v *formatter_) appendNewline() {
 	var newline = "\n"
 	var indentation = "\t"
@@ -594,9 +824,13 @@ func (v *formatter_) getResult() string {
 
 type formatter_ struct {
 	// Declare the instance attributes.
-	visitor_ VisitorLike
-	depth_   uint
-	result_  sts.Builder
+	visitor_            VisitorLike
+	depth_              uint
+	parameter_          ast.ParameterLike
+	constantDefinition_ ast.ConstantDefinitionLike
+	additionalValue_    ast.AdditionalValueLike
+	fields_             int
+	result_             sts.Builder
 
 	// Declare the inherited aspects.
 	Methodical
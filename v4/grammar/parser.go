@@ -14,12 +14,17 @@
 package grammar
 
 import (
+	ers "errors"
 	fmt "fmt"
 	col "github.com/craterdog/go-collection-framework/v4"
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	uti "github.com/craterdog/go-missing-utilities/v2"
 	ast "github.com/craterdog/go-model-framework/v4/ast"
+	fsx "io/fs"
+	osx "os"
+	fil "path/filepath"
 	sts "strings"
+	syn "sync"
 )
 
 // CLASS INTERFACE
@@ -47,19 +52,24 @@ func (v *parser_) GetClass() ParserClassLike {
 	return v.getClass()
 }
 
-// NOTE: For consistent error reporting we must replace each tab with 4
-// spaces prior to scanning for tokens.  Unfortunately this will replace
-// any tabs in comments as well.  What's worse, the `go fmt` command
-// automatically replaces any leading spaces on each indented line in a
-// comment with a tab.  Very annoying!
+func (v *parser_) ParseDirectory(
+	directory string,
+) WorkspaceLike {
+	var workspace, err = v.TryParseDirectory(directory)
+	if err != nil {
+		panic(err)
+	}
+	return workspace
+}
+
 func (v *parser_) ParseSource(
 	source string,
 ) ast.ModelLike {
-	// Create a scanner running in a separate Go routine.
-	v.source_ = sts.ReplaceAll(source, "\t", "    ")
-	v.tokens_ = col.Queue[TokenLike](v.getClass().queueSize_)
-	Scanner().Make(v.source_, v.tokens_)
+	// Create a scanner that runs on this goroutine as tokens are needed.
+	v.source_ = source
+	v.scanner_ = Scanner().MakeWithSource(v.source_)
 	v.next_ = col.Stack[TokenLike](v.getClass().stackSize_)
+	v.history_ = nil
 
 	// Attempt to parse the model from the token stream.
 	var result_, token, ok = v.parseModel()
@@ -70,6 +80,120 @@ func (v *parser_) ParseSource(
 	return result_
 }
 
+func (v *parser_) TryParseDirectory(
+	directory string,
+) (
+	workspace WorkspaceLike,
+	err error,
+) {
+	// Convert a directory tree that cannot be searched into a returned error.
+	defer func() {
+		if e := recover(); e != nil {
+			workspace = nil
+			err = fmt.Errorf("%v", e)
+		}
+	}()
+
+	// Determine the module containing the directory tree.
+	var root, modulePath = v.findModule(directory)
+
+	// Parse each model file concurrently using a separate parser for each.
+	var filenames = v.findModels(directory)
+	var models = make([]ast.ModelLike, len(filenames))
+	var failures = make([]error, len(filenames))
+	var group syn.WaitGroup
+	for index, filename := range filenames {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			defer func() {
+				if e := recover(); e != nil {
+					failures[index] = fmt.Errorf("%v: %v", filename, e)
+				}
+			}()
+			var bytes, err = osx.ReadFile(filename)
+			if err == nil {
+				models[index], err = Parser().Make().TryParseSource(string(bytes))
+			}
+			if err != nil {
+				failures[index] = fmt.Errorf("%v: %w", filename, err)
+			}
+		}()
+	}
+	group.Wait()
+
+	// Report every model file that could not be parsed rather than just the
+	// first one.
+	err = ers.Join(failures...)
+	if err != nil {
+		return workspace, err
+	}
+
+	// Key each model by the import path of its package.
+	var catalog = col.Catalog[string, ast.ModelLike]()
+	for index, filename := range filenames {
+		var packagePath = v.extractPackagePath(root, modulePath, filename)
+		catalog.SetValue(packagePath, models[index])
+	}
+	catalog.SortValues()
+	workspace = Workspace().Make(modulePath, catalog)
+	return workspace, err
+}
+
+func (v *parser_) TryParseSource(
+	source string,
+) (
+	model ast.ModelLike,
+	err error,
+) {
+	// Convert any syntax error that is found into a returned error.
+	v.error_ = nil
+	defer func() {
+		if e := recover(); e != nil {
+			if uti.IsUndefined(v.error_) {
+				// This is not a syntax error so pass it on.
+				panic(e)
+			}
+			model = nil
+			err = v.error_
+		}
+	}()
+
+	// Attempt to parse the model from the source.
+	model = v.ParseSource(source)
+	return model, err
+}
+
+func (v *parser_) ParseSourceWithRecovery(
+	source string,
+) (
+	model ast.ModelLike,
+	errors abs.Sequential[SyntaxErrorLike],
+) {
+	// Record each syntax error and resynchronize rather than giving up.
+	var errors_ = col.List[SyntaxErrorLike]()
+	v.errors_ = errors_
+	v.error_ = nil
+	defer func() {
+		v.errors_ = nil
+		if e := recover(); e != nil {
+			if uti.IsUndefined(v.error_) {
+				// This is not a syntax error so pass it on.
+				panic(e)
+			}
+			errors_.AppendValue(v.error_)
+		}
+		if !errors_.IsEmpty() {
+			model = nil
+		}
+		errors = errors_
+	}()
+
+	// Attempt to parse the model from the source.
+	model = v.ParseSource(source)
+	return model, errors
+}
+
 // Private Methods
 
 func (v *parser_) getClass() *parserClass_ {
@@ -81,13 +205,33 @@ func (v *parser_) parseAbstraction() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
-	// Attempt to parse an optional prefix rule.
-	var optionalPrefix ast.PrefixLike
-	optionalPrefix, _, ok = v.parsePrefix()
-	if ok {
+	// Attempt to parse 0 to unlimited prefix rules.
+	var prefixes = col.List[ast.PrefixLike]()
+prefixesLoop:
+	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+		var prefix ast.PrefixLike
+		prefix, token, ok = v.parsePrefix()
+		if !ok {
+			break prefixesLoop
+		}
 		ruleFound_ = true
+		prefixes.AppendValue(prefix)
+	}
+
+	// Attempt to parse an optional function rule.
+	var optionalFunction ast.FunctionLike
+	optionalFunction, token, ok = v.parseFunction()
+	if ok {
+		// Found a single function abstraction rule.
+		abstraction = ast.Abstraction().MakeWithFunction(
+			prefixes,
+			optionalFunction,
+		)
+		abstraction.SetSpan(v.getSpan(first_))
+		return abstraction, token, true
 	}
 
 	// Attempt to parse a single name token.
@@ -122,11 +266,12 @@ func (v *parser_) parseAbstraction() (
 	// Found a single abstraction rule.
 	ruleFound_ = true
 	abstraction = ast.Abstraction().Make(
-		optionalPrefix,
+		prefixes,
 		name,
 		optionalSuffix,
 		optionalArguments,
 	)
+	abstraction.SetSpan(v.getSpan(first_))
 	return abstraction, token, ruleFound_
 }
 
@@ -135,6 +280,7 @@ func (v *parser_) parseAdditionalArgument() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "," delimiter.
@@ -169,6 +315,7 @@ func (v *parser_) parseAdditionalArgument() (
 	// Found a single additionalArgument rule.
 	ruleFound_ = true
 	additionalArgument = ast.AdditionalArgument().Make(argument)
+	additionalArgument.SetSpan(v.getSpan(first_))
 	return additionalArgument, token, ruleFound_
 }
 
@@ -177,6 +324,7 @@ func (v *parser_) parseAdditionalConstraint() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "," delimiter.
@@ -211,19 +359,158 @@ func (v *parser_) parseAdditionalConstraint() (
 	// Found a single additionalConstraint rule.
 	ruleFound_ = true
 	additionalConstraint = ast.AdditionalConstraint().Make(constraint)
+	additionalConstraint.SetSpan(v.getSpan(first_))
 	return additionalConstraint, token, ruleFound_
 }
 
+func (v *parser_) parseAdditionalExpression() (
+	additionalExpression ast.AdditionalExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "," delimiter.
+	_, token, ok = v.parseDelimiter(",")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "AdditionalExpression")
+			panic(message)
+		} else {
+			// This is not a single additionalExpression rule.
+			return additionalExpression, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single expression rule.
+	var expression ast.ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "AdditionalExpression")
+			panic(message)
+		} else {
+			// This is not a single additionalExpression rule.
+			return additionalExpression, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single additionalExpression rule.
+	ruleFound_ = true
+	additionalExpression = ast.AdditionalExpression().Make(
+		expression,
+	)
+	additionalExpression.SetSpan(v.getSpan(first_))
+	return additionalExpression, token, ruleFound_
+}
+
+func (v *parser_) parseAdditionalOutput() (
+	additionalOutput ast.AdditionalOutputLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "," delimiter.
+	_, token, ok = v.parseDelimiter(",")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "AdditionalOutput")
+			panic(message)
+		} else {
+			// This is not a single additionalOutput rule.
+			return additionalOutput, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single output rule.
+	var output ast.OutputLike
+	output, token, ok = v.parseOutput()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "AdditionalOutput")
+			panic(message)
+		} else {
+			// This is not a single additionalOutput rule.
+			return additionalOutput, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single additionalOutput rule.
+	ruleFound_ = true
+	additionalOutput = ast.AdditionalOutput().Make(output)
+	additionalOutput.SetSpan(v.getSpan(first_))
+	return additionalOutput, token, ruleFound_
+}
+
+func (v *parser_) parseAdditionalTerm() (
+	additionalTerm ast.AdditionalTermLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "|" delimiter.
+	_, token, ok = v.parseDelimiter("|")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "AdditionalTerm")
+			panic(message)
+		} else {
+			// This is not a single additionalTerm rule.
+			return additionalTerm, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single term rule.
+	var term ast.TermLike
+	term, token, ok = v.parseTerm()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "AdditionalTerm")
+			panic(message)
+		} else {
+			// This is not a single additionalTerm rule.
+			return additionalTerm, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single additionalTerm rule.
+	ruleFound_ = true
+	additionalTerm = ast.AdditionalTerm().Make(term)
+	additionalTerm.SetSpan(v.getSpan(first_))
+	return additionalTerm, token, ruleFound_
+}
+
 func (v *parser_) parseAdditionalValue() (
 	additionalValue ast.AdditionalValueLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
-	// Attempt to parse a single name token.
+	// Attempt to parse a single name token or "_" delimiter.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
+	if !ok {
+		name, token, ok = v.parseDelimiter("_")
+	}
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
@@ -236,17 +523,91 @@ func (v *parser_) parseAdditionalValue() (
 	}
 	ruleFound_ = true
 
+	// A value without an initializer ends with its line so its type may
+	// not be confused with the name of the next value.
+	var newline TokenLike
+	_, newline, ok = v.parseToken(NewlineToken)
+	if ok {
+		v.putBack(newline)
+		additionalValue = ast.AdditionalValue().Make(name, nil, nil)
+		additionalValue.SetSpan(v.getSpan(first_))
+		return additionalValue, token, ruleFound_
+	}
+
+	// Attempt to parse an optional abstraction rule.
+	var optionalAbstraction ast.AbstractionLike
+	optionalAbstraction, _, ok = v.parseAbstraction()
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse an optional initializer rule.
+	var optionalInitializer ast.InitializerLike
+	optionalInitializer, token, ok = v.parseInitializer()
+	if ok {
+		ruleFound_ = true
+	} else if uti.IsDefined(optionalAbstraction) {
+		// A value that declares its type must also declare its initial value.
+		var message = v.formatError(token, "AdditionalValue")
+		panic(message)
+	}
+
 	// Found a single additionalValue rule.
 	ruleFound_ = true
-	additionalValue = ast.AdditionalValue().Make(name)
+	additionalValue = ast.AdditionalValue().Make(
+		name,
+		optionalAbstraction,
+		optionalInitializer,
+	)
+	additionalValue.SetSpan(v.getSpan(first_))
 	return additionalValue, token, ruleFound_
 }
 
+func (v *parser_) parseAlias() (
+	alias ast.AliasLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	// Attempt to parse a single name token.
+	var name string
+	name, token, ok = v.parseToken(NameToken)
+	if ok {
+		// Found a single name alias.
+		alias = ast.Alias().Make(name)
+		alias.SetSpan(v.getSpan(first_))
+		return alias, token, true
+	}
+
+	// Attempt to parse a single "." delimiter.
+	var delimiter string
+	delimiter, token, ok = v.parseDelimiter(".")
+	if ok {
+		// Found a single "." alias.
+		alias = ast.Alias().Make(delimiter)
+		alias.SetSpan(v.getSpan(first_))
+		return alias, token, true
+	}
+
+	// Attempt to parse a single "_" delimiter.
+	delimiter, token, ok = v.parseDelimiter("_")
+	if ok {
+		// Found a single "_" alias.
+		alias = ast.Alias().Make(delimiter)
+		alias.SetSpan(v.getSpan(first_))
+		return alias, token, true
+	}
+
+	// This is not a single alias rule.
+	return alias, token, false
+}
+
 func (v *parser_) parseArgument() (
 	argument ast.ArgumentLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single abstraction rule.
@@ -267,6 +628,7 @@ func (v *parser_) parseArgument() (
 	// Found a single argument rule.
 	ruleFound_ = true
 	argument = ast.Argument().Make(abstraction)
+	argument.SetSpan(v.getSpan(first_))
 	return argument, token, ruleFound_
 }
 
@@ -275,6 +637,7 @@ func (v *parser_) parseArguments() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "[" delimiter.
@@ -289,7 +652,10 @@ func (v *parser_) parseArguments() (
 			return arguments, token, false
 		}
 	}
-	ruleFound_ = true
+
+	// NOTE: The name of a parameter that is followed by an array prefix may be
+	// mistaken for a type with arguments when attempting to parse a tuple so the
+	// rule is not committed to until the first argument rule is found.
 
 	// Attempt to parse a single argument rule.
 	var argument ast.ArgumentLike
@@ -301,6 +667,7 @@ func (v *parser_) parseArguments() (
 			panic(message)
 		} else {
 			// This is not a single arguments rule.
+			v.backtrack(first_)
 			return arguments, token, false
 		}
 	}
@@ -350,6 +717,7 @@ additionalArgumentsLoop:
 		argument,
 		additionalArguments,
 	)
+	arguments.SetSpan(v.getSpan(first_))
 	return arguments, token, ruleFound_
 }
 
@@ -358,6 +726,7 @@ func (v *parser_) parseArray() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "[" delimiter.
@@ -374,6 +743,13 @@ func (v *parser_) parseArray() (
 	}
 	ruleFound_ = true
 
+	// Attempt to parse an optional size rule.
+	var optionalSize ast.SizeLike
+	optionalSize, _, ok = v.parseSize()
+	if ok {
+		ruleFound_ = true
+	}
+
 	// Attempt to parse a single "]" delimiter.
 	_, token, ok = v.parseDelimiter("]")
 	if !ok {
@@ -390,7 +766,8 @@ func (v *parser_) parseArray() (
 
 	// Found a single array rule.
 	ruleFound_ = true
-	array = ast.Array().Make()
+	array = ast.Array().Make(optionalSize)
+	array.SetSpan(v.getSpan(first_))
 	return array, token, ruleFound_
 }
 
@@ -399,6 +776,7 @@ func (v *parser_) parseAspectDefinition() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single declaration rule.
@@ -444,7 +822,31 @@ func (v *parser_) parseAspectDefinition() (
 	}
 	ruleFound_ = true
 
-	// Attempt to parse 1 to unlimited aspectMethod rules.
+	// Attempt to parse 0 to unlimited aspectInterface rules.
+	var aspectInterfaces = col.List[ast.AspectInterfaceLike]()
+aspectInterfacesLoop:
+	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+		var aspectInterface ast.AspectInterfaceLike
+		aspectInterface, token, ok = v.parseAspectInterface()
+		if !ok {
+			switch {
+			case numberFound_ < 0:
+				if !ruleFound_ {
+					// This is not a single aspect rule.
+					return aspectDefinition, token, false
+				}
+				// Found a syntax error.
+				var message = v.formatError(token, "AspectDefinition")
+				message += "The number of aspectInterface rules must be at least 0."
+				panic(message)
+			default:
+				break aspectInterfacesLoop
+			}
+		}
+		aspectInterfaces.AppendValue(aspectInterface)
+	}
+
+	// Attempt to parse 0 to unlimited aspectMethod rules.
 	var aspectMethods = col.List[ast.AspectMethodLike]()
 aspectMethodsLoop:
 	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
@@ -452,14 +854,14 @@ aspectMethodsLoop:
 		aspectMethod, token, ok = v.parseAspectMethod()
 		if !ok {
 			switch {
-			case numberFound_ < 1:
+			case numberFound_ < 0:
 				if !ruleFound_ {
 					// This is not a single aspect rule.
 					return aspectDefinition, token, false
 				}
 				// Found a syntax error.
 				var message = v.formatError(token, "AspectDefinition")
-				message += "The number of aspectMethod rules must be at least 1."
+				message += "The number of aspectMethod rules must be at least 0."
 				panic(message)
 			default:
 				break aspectMethodsLoop
@@ -468,6 +870,14 @@ aspectMethodsLoop:
 		aspectMethods.AppendValue(aspectMethod)
 	}
 
+	// An aspect must embed another aspect or define a method.
+	if aspectInterfaces.IsEmpty() && aspectMethods.IsEmpty() {
+		// Found a syntax error.
+		var message = v.formatError(token, "AspectDefinition")
+		message += "An aspect must embed another aspect or define a method."
+		panic(message)
+	}
+
 	// Attempt to parse a single "}" delimiter.
 	_, token, ok = v.parseDelimiter("}")
 	if !ok {
@@ -486,8 +896,10 @@ aspectMethodsLoop:
 	ruleFound_ = true
 	aspectDefinition = ast.AspectDefinition().Make(
 		declaration,
+		aspectInterfaces,
 		aspectMethods,
 	)
+	aspectDefinition.SetSpan(v.getSpan(first_))
 	return aspectDefinition, token, ruleFound_
 }
 
@@ -496,6 +908,7 @@ func (v *parser_) parseAspectInterface() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single abstraction rule.
@@ -513,11 +926,22 @@ func (v *parser_) parseAspectInterface() (
 	}
 	ruleFound_ = true
 
+	// NOTE: An embedded aspect and an aspect method both begin with a name so
+	// the tokens for the name must be returned to the token stream if it turns
+	// out to be the name of a method.
+	_, token, ok = v.parseDelimiter("(")
+	if ok {
+		// This is not a single interface rule.
+		v.backtrack(first_)
+		return aspectInterface, token, false
+	}
+
 	// Found a single interface rule.
 	ruleFound_ = true
 	aspectInterface = ast.AspectInterface().Make(
 		abstraction,
 	)
+	aspectInterface.SetSpan(v.getSpan(first_))
 	return aspectInterface, token, ruleFound_
 }
 
@@ -526,6 +950,7 @@ func (v *parser_) parseAspectMethod() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single method rule.
@@ -548,6 +973,7 @@ func (v *parser_) parseAspectMethod() (
 	aspectMethod = ast.AspectMethod().Make(
 		method,
 	)
+	aspectMethod.SetSpan(v.getSpan(first_))
 	return aspectMethod, token, ruleFound_
 }
 
@@ -556,6 +982,7 @@ func (v *parser_) parseAspectSection() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "// Aspect Definitions" delimiter.
@@ -573,11 +1000,12 @@ func (v *parser_) parseAspectSection() (
 	ruleFound_ = true
 
 	// Attempt to parse 1 to unlimited aspectDefinition rules.
+	var errorsFound_ = v.errorsFound()
 	var aspectDefinitions = col.List[ast.AspectDefinitionLike]()
 aspectDefinitionsLoop:
 	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
 		var aspectDefinition ast.AspectDefinitionLike
-		aspectDefinition, token, ok = v.parseAspectDefinition()
+		aspectDefinition, token, ok = parseDefinition(v, v.parseAspectDefinition)
 		if !ok {
 			switch {
 			case numberFound_ < 1:
@@ -585,6 +1013,10 @@ aspectDefinitionsLoop:
 					// This is not a single aspectSection rule.
 					return aspectSection, token, false
 				}
+				if v.errorsFound() > errorsFound_ {
+					// The syntax errors have already been recorded.
+					break aspectDefinitionsLoop
+				}
 				// Found a syntax error.
 				var message = v.formatError(token, "AspectSection")
 				message += "The number of aspectDefinition rules must be at least 1."
@@ -615,6 +1047,7 @@ aspectDefinitionsLoop:
 	// Found a single aspectSection rule.
 	ruleFound_ = true
 	aspectSection = ast.AspectSection().Make(aspectDefinitions)
+	aspectSection.SetSpan(v.getSpan(first_))
 	return aspectSection, token, ruleFound_
 }
 
@@ -623,6 +1056,7 @@ func (v *parser_) parseAspectSubsection() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "// Aspect Methods" delimiter.
@@ -666,6 +1100,7 @@ interfacesLoop:
 	// Found a single aspectSubsection rule.
 	ruleFound_ = true
 	aspectSubsection = ast.AspectSubsection().Make(interfaces)
+	aspectSubsection.SetSpan(v.getSpan(first_))
 	return aspectSubsection, token, ruleFound_
 }
 
@@ -674,12 +1109,14 @@ func (v *parser_) parseAttributeMethod() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	// Attempt to parse a single getterMethod rule.
 	var getterMethod ast.GetterMethodLike
 	getterMethod, token, ok = v.parseGetterMethod()
 	if ok {
 		// Found a single getterMethod attributeMethod.
 		attributeMethod = ast.AttributeMethod().Make(getterMethod)
+		attributeMethod.SetSpan(v.getSpan(first_))
 		return attributeMethod, token, true
 	}
 
@@ -689,6 +1126,7 @@ func (v *parser_) parseAttributeMethod() (
 	if ok {
 		// Found a single setterMethod attributeMethod.
 		attributeMethod = ast.AttributeMethod().Make(setterMethod)
+		attributeMethod.SetSpan(v.getSpan(first_))
 		return attributeMethod, token, true
 	}
 
@@ -701,6 +1139,7 @@ func (v *parser_) parseAttributeSubsection() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "// Attribute Methods" delimiter.
@@ -744,6 +1183,7 @@ attributeMethodsLoop:
 	// Found a single attributeSubsection rule.
 	ruleFound_ = true
 	attributeSubsection = ast.AttributeSubsection().Make(attributeMethods)
+	attributeSubsection.SetSpan(v.getSpan(first_))
 	return attributeSubsection, token, ruleFound_
 }
 
@@ -752,8 +1192,17 @@ func (v *parser_) parseChannel() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
+	// Attempt to parse a receive-only channel: "<-" "chan".
+	var direction = ast.Bidirectional
+	_, _, ok = v.parseDelimiter("<-")
+	if ok {
+		ruleFound_ = true
+		direction = ast.Receive
+	}
+
 	// Attempt to parse a single "chan" delimiter.
 	_, token, ok = v.parseDelimiter("chan")
 	if !ok {
@@ -768,9 +1217,19 @@ func (v *parser_) parseChannel() (
 	}
 	ruleFound_ = true
 
+	// Otherwise attempt to parse an optional "<-" delimiter for a send-only
+	// channel: "chan" "<-"?.
+	if direction == ast.Bidirectional {
+		_, _, ok = v.parseDelimiter("<-")
+		if ok {
+			direction = ast.Send
+		}
+	}
+
 	// Found a single channel rule.
 	ruleFound_ = true
-	channel = ast.Channel().Make()
+	channel = ast.Channel().Make(direction)
+	channel.SetSpan(v.getSpan(first_))
 	return channel, token, ruleFound_
 }
 
@@ -779,6 +1238,7 @@ func (v *parser_) parseClass() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single declaration rule.
@@ -859,6 +1319,7 @@ func (v *parser_) parseClass() (
 		declaration,
 		classMethods,
 	)
+	classDefinition.SetSpan(v.getSpan(first_))
 	return classDefinition, token, ruleFound_
 }
 
@@ -867,6 +1328,7 @@ func (v *parser_) parseClassMethods() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single constructorSubsection rule.
@@ -905,6 +1367,7 @@ func (v *parser_) parseClassMethods() (
 		optionalConstantSubsection,
 		optionalFunctionSubsection,
 	)
+	classMethods.SetSpan(v.getSpan(first_))
 	return classMethods, token, ruleFound_
 }
 
@@ -913,6 +1376,7 @@ func (v *parser_) parseClassSection() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "// Class Definitions" delimiter.
@@ -930,11 +1394,12 @@ func (v *parser_) parseClassSection() (
 	ruleFound_ = true
 
 	// Attempt to parse 1 to unlimited classDefinition rules.
+	var errorsFound_ = v.errorsFound()
 	var classDefinitions = col.List[ast.ClassDefinitionLike]()
 classDefinitionsLoop:
 	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
 		var classDefinition ast.ClassDefinitionLike
-		classDefinition, token, ok = v.parseClass()
+		classDefinition, token, ok = parseDefinition(v, v.parseClass)
 		if !ok {
 			switch {
 			case numberFound_ < 1:
@@ -942,6 +1407,10 @@ classDefinitionsLoop:
 					// This is not a single classSection rule.
 					return classSection, token, false
 				}
+				if v.errorsFound() > errorsFound_ {
+					// The syntax errors have already been recorded.
+					break classDefinitionsLoop
+				}
 				// Found a syntax error.
 				var message = v.formatError(token, "ClassSection")
 				message += "The number of classDefinition rules must be at least 1."
@@ -972,101 +1441,259 @@ classDefinitionsLoop:
 	// Found a single classSection rule.
 	ruleFound_ = true
 	classSection = ast.ClassSection().Make(classDefinitions)
+	classSection.SetSpan(v.getSpan(first_))
 	return classSection, token, ruleFound_
 }
 
-func (v *parser_) parseConstantMethod() (
-	constantMethod ast.ConstantMethodLike,
+func (v *parser_) parseConstantDefinition() (
+	constantDefinition ast.ConstantDefinitionLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
-	// Attempt to parse a single name token.
-	var name string
-	name, token, ok = v.parseToken(NameToken)
+	// Attempt to parse a single comment token.
+	var comment string
+	comment, token, ok = v.parseToken(CommentToken)
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "ConstantMethod")
+			var message = v.formatError(token, "ConstantDefinition")
 			panic(message)
 		} else {
-			// This is not a single constantMethod rule.
-			return constantMethod, token, false
+			// This is not a single constantDefinition rule.
+			return constantDefinition, token, false
 		}
 	}
 	ruleFound_ = true
 
-	// Attempt to parse a single "(" delimiter.
-	_, token, ok = v.parseDelimiter("(")
+	// Attempt to parse a single qualifier rule.
+	var qualifier ast.QualifierLike
+	qualifier, token, ok = v.parseQualifier()
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "ConstantMethod")
+			var message = v.formatError(token, "ConstantDefinition")
 			panic(message)
 		} else {
-			// This is not a single constantMethod rule.
-			return constantMethod, token, false
+			// This is not a single constantDefinition rule.
+			return constantDefinition, token, false
 		}
 	}
 	ruleFound_ = true
 
-	// Attempt to parse a single ")" delimiter.
-	_, token, ok = v.parseDelimiter(")")
+	// Attempt to parse a single name token.
+	var name string
+	name, token, ok = v.parseToken(NameToken)
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "ConstantMethod")
+			var message = v.formatError(token, "ConstantDefinition")
 			panic(message)
 		} else {
-			// This is not a single constantMethod rule.
-			return constantMethod, token, false
+			// This is not a single constantDefinition rule.
+			return constantDefinition, token, false
 		}
 	}
 	ruleFound_ = true
 
-	// Attempt to parse a single abstraction rule.
-	var abstraction ast.AbstractionLike
-	abstraction, token, ok = v.parseAbstraction()
+	// Attempt to parse an optional abstraction rule.
+	var optionalAbstraction ast.AbstractionLike
+	optionalAbstraction, _, ok = v.parseAbstraction()
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse a single initializer rule.
+	var initializer ast.InitializerLike
+	initializer, token, ok = v.parseInitializer()
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "ConstantMethod")
+			var message = v.formatError(token, "ConstantDefinition")
 			panic(message)
 		} else {
-			// This is not a single constantMethod rule.
-			return constantMethod, token, false
+			// This is not a single constantDefinition rule.
+			return constantDefinition, token, false
 		}
 	}
 	ruleFound_ = true
 
-	// Found a single constantMethod rule.
+	// Found a single constantDefinition rule.
 	ruleFound_ = true
-	constantMethod = ast.ConstantMethod().Make(
+	constantDefinition = ast.ConstantDefinition().Make(
+		comment,
+		qualifier,
 		name,
-		abstraction,
+		optionalAbstraction,
+		initializer,
 	)
-	return constantMethod, token, ruleFound_
+	constantDefinition.SetSpan(v.getSpan(first_))
+	return constantDefinition, token, ruleFound_
 }
 
-func (v *parser_) parseConstantSubsection() (
-	constantSubsection ast.ConstantSubsectionLike,
+func (v *parser_) parseConstantMethod() (
+	constantMethod ast.ConstantMethodLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
-	// Attempt to parse a single "// Constant Methods" delimiter.
-	_, token, ok = v.parseDelimiter("// Constant Methods")
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse a single name token.
+	var name string
+	name, token, ok = v.parseToken(NameToken)
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "ConstantSubsection")
+			var message = v.formatError(token, "ConstantMethod")
 			panic(message)
 		} else {
-			// This is not a single constantSubsection rule.
-			return constantSubsection, token, false
-		}
+			// This is not a single constantMethod rule.
+			return constantMethod, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single "(" delimiter.
+	_, token, ok = v.parseDelimiter("(")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "ConstantMethod")
+			panic(message)
+		} else {
+			// This is not a single constantMethod rule.
+			return constantMethod, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single ")" delimiter.
+	_, token, ok = v.parseDelimiter(")")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "ConstantMethod")
+			panic(message)
+		} else {
+			// This is not a single constantMethod rule.
+			return constantMethod, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single abstraction rule.
+	var abstraction ast.AbstractionLike
+	abstraction, token, ok = v.parseAbstraction()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "ConstantMethod")
+			panic(message)
+		} else {
+			// This is not a single constantMethod rule.
+			return constantMethod, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single constantMethod rule.
+	ruleFound_ = true
+	constantMethod = ast.ConstantMethod().Make(
+		optionalNote,
+		name,
+		abstraction,
+	)
+	constantMethod.SetSpan(v.getSpan(first_))
+	return constantMethod, token, ruleFound_
+}
+
+func (v *parser_) parseConstantSection() (
+	constantSection ast.ConstantSectionLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "// Constant Definitions" delimiter.
+	_, token, ok = v.parseDelimiter("// Constant Definitions")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "ConstantSection")
+			panic(message)
+		} else {
+			// This is not a single constantSection rule.
+			return constantSection, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse 1 to unlimited constantDefinition rules.
+	var errorsFound_ = v.errorsFound()
+	var constantDefinitions = col.List[ast.ConstantDefinitionLike]()
+constantDefinitionsLoop:
+	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+		var constantDefinition ast.ConstantDefinitionLike
+		constantDefinition, token, ok = parseDefinition(v, v.parseConstantDefinition)
+		if !ok {
+			switch {
+			case numberFound_ < 1:
+				if !ruleFound_ {
+					// This is not a single constantSection rule.
+					return constantSection, token, false
+				}
+				if v.errorsFound() > errorsFound_ {
+					// The syntax errors have already been recorded.
+					break constantDefinitionsLoop
+				}
+				// Found a syntax error.
+				var message = v.formatError(token, "ConstantSection")
+				message += "The number of constantDefinition rules must be at least 1."
+				panic(message)
+			default:
+				break constantDefinitionsLoop
+			}
+		}
+		constantDefinitions.AppendValue(constantDefinition)
+	}
+
+	// Found a single constantSection rule.
+	ruleFound_ = true
+	constantSection = ast.ConstantSection().Make(constantDefinitions)
+	constantSection.SetSpan(v.getSpan(first_))
+	return constantSection, token, ruleFound_
+}
+
+func (v *parser_) parseConstantSubsection() (
+	constantSubsection ast.ConstantSubsectionLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "// Constant Methods" delimiter.
+	_, token, ok = v.parseDelimiter("// Constant Methods")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "ConstantSubsection")
+			panic(message)
+		} else {
+			// This is not a single constantSubsection rule.
+			return constantSubsection, token, false
+		}
 	}
 	ruleFound_ = true
 
@@ -1097,6 +1724,7 @@ constantMethodsLoop:
 	// Found a single constantSubsection rule.
 	ruleFound_ = true
 	constantSubsection = ast.ConstantSubsection().Make(constantMethods)
+	constantSubsection.SetSpan(v.getSpan(first_))
 	return constantSubsection, token, ruleFound_
 }
 
@@ -1105,6 +1733,7 @@ func (v *parser_) parseConstraint() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single name token.
@@ -1120,11 +1749,20 @@ func (v *parser_) parseConstraint() (
 			return constraint, token, false
 		}
 	}
+
+	// NOTE: A fixed-size array with a named size also begins with a "[" and a
+	// name, but the name of its size is followed by a "]" delimiter.
+	_, token, ok = v.parseDelimiter("]")
+	if ok {
+		// This is not a single constraint rule.
+		v.backtrack(first_)
+		return constraint, token, false
+	}
 	ruleFound_ = true
 
-	// Attempt to parse a single abstraction rule.
-	var abstraction ast.AbstractionLike
-	abstraction, token, ok = v.parseAbstraction()
+	// Attempt to parse a single term rule.
+	var term ast.TermLike
+	term, token, ok = v.parseTerm()
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
@@ -1132,17 +1770,44 @@ func (v *parser_) parseConstraint() (
 			panic(message)
 		} else {
 			// This is not a single constraint rule.
+			v.backtrack(first_)
 			return constraint, token, false
 		}
 	}
 	ruleFound_ = true
 
+	// Attempt to parse 0 to unlimited additionalTerm rules.
+	var additionalTerms = col.List[ast.AdditionalTermLike]()
+additionalTermsLoop:
+	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+		var additionalTerm ast.AdditionalTermLike
+		additionalTerm, token, ok = v.parseAdditionalTerm()
+		if !ok {
+			switch {
+			case numberFound_ < 0:
+				if !ruleFound_ {
+					// This is not a single constraint rule.
+					return constraint, token, false
+				}
+				// Found a syntax error.
+				var message = v.formatError(token, "Constraint")
+				message += "The number of additionalTerm rules must be at least 0."
+				panic(message)
+			default:
+				break additionalTermsLoop
+			}
+		}
+		additionalTerms.AppendValue(additionalTerm)
+	}
+
 	// Found a single constraint rule.
 	ruleFound_ = true
 	constraint = ast.Constraint().Make(
 		name,
-		abstraction,
+		term,
+		additionalTerms,
 	)
+	constraint.SetSpan(v.getSpan(first_))
 	return constraint, token, ruleFound_
 }
 
@@ -1151,6 +1816,7 @@ func (v *parser_) parseConstraints() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "[" delimiter.
@@ -1165,7 +1831,9 @@ func (v *parser_) parseConstraints() (
 			return constraints, token, false
 		}
 	}
-	ruleFound_ = true
+
+	// NOTE: A fixed-size array also begins with a "[" delimiter so the rule is
+	// not committed to until the first constraint rule is found.
 
 	// Attempt to parse a single constraint rule.
 	var constraint ast.ConstraintLike
@@ -1177,6 +1845,7 @@ func (v *parser_) parseConstraints() (
 			panic(message)
 		} else {
 			// This is not a single constraints rule.
+			v.backtrack(first_)
 			return constraints, token, false
 		}
 	}
@@ -1226,6 +1895,7 @@ additionalConstraintsLoop:
 		constraint,
 		additionalConstraints,
 	)
+	constraints.SetSpan(v.getSpan(first_))
 	return constraints, token, ruleFound_
 }
 
@@ -1234,8 +1904,16 @@ func (v *parser_) parseConstructorMethod() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
 	// Attempt to parse a single name token.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
@@ -1321,10 +1999,12 @@ parametersLoop:
 	// Found a single constructorMethod rule.
 	ruleFound_ = true
 	constructorMethod = ast.ConstructorMethod().Make(
+		optionalNote,
 		name,
 		parameters,
 		abstraction,
 	)
+	constructorMethod.SetSpan(v.getSpan(first_))
 	return constructorMethod, token, ruleFound_
 }
 
@@ -1333,6 +2013,7 @@ func (v *parser_) parseConstructorSubsection() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "// Constructor Methods" delimiter.
@@ -1376,6 +2057,7 @@ constructorMethodsLoop:
 	// Found a single constructorSubsection rule.
 	ruleFound_ = true
 	constructorSubsection = ast.ConstructorSubsection().Make(constructorMethods)
+	constructorSubsection.SetSpan(v.getSpan(first_))
 	return constructorSubsection, token, ruleFound_
 }
 
@@ -1384,6 +2066,7 @@ func (v *parser_) parseDeclaration() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single comment token.
@@ -1444,6 +2127,7 @@ func (v *parser_) parseDeclaration() (
 		name,
 		optionalConstraints,
 	)
+	declaration.SetSpan(v.getSpan(first_))
 	return declaration, token, ruleFound_
 }
 
@@ -1452,6 +2136,7 @@ func (v *parser_) parseEnumeration() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "const" delimiter.
@@ -1541,176 +2226,406 @@ additionalValuesLoop:
 		value,
 		additionalValues,
 	)
+	enumeration.SetSpan(v.getSpan(first_))
 	return enumeration, token, ruleFound_
 }
 
-func (v *parser_) parseFunctionMethod() (
-	functionMethod ast.FunctionMethodLike,
+func (v *parser_) parseExpression() (
+	expression ast.ExpressionLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
-	// Attempt to parse a single name token.
-	var name string
-	name, token, ok = v.parseToken(NameToken)
-	if !ok {
-		if ruleFound_ {
-			// Found a syntax error.
-			var message = v.formatError(token, "FunctionMethod")
-			panic(message)
-		} else {
-			// This is not a single functionMethod rule.
-			return functionMethod, token, false
-		}
-	}
-	ruleFound_ = true
-
-	// Attempt to parse a single "(" delimiter.
-	_, token, ok = v.parseDelimiter("(")
+	// Attempt to parse a single operand rule.
+	var operand ast.OperandLike
+	operand, token, ok = v.parseOperand()
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "FunctionMethod")
+			var message = v.formatError(token, "Expression")
 			panic(message)
 		} else {
-			// This is not a single functionMethod rule.
-			return functionMethod, token, false
+			// This is not a single expression rule.
+			return expression, token, false
 		}
 	}
 	ruleFound_ = true
 
-	// Attempt to parse 0 to unlimited parameter rules.
-	var parameters = col.List[ast.ParameterLike]()
-parametersLoop:
+	// Attempt to parse 0 to unlimited operation rules.
+	var operations = col.List[ast.OperationLike]()
+operationsLoop:
 	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
-		var parameter ast.ParameterLike
-		parameter, token, ok = v.parseParameter()
+		var operation ast.OperationLike
+		operation, token, ok = v.parseOperation()
 		if !ok {
 			switch {
 			case numberFound_ < 0:
 				if !ruleFound_ {
-					// This is not a single functionMethod rule.
-					return functionMethod, token, false
+					// This is not a single expression rule.
+					return expression, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "FunctionMethod")
-				message += "The number of parameter rules must be at least 0."
+				var message = v.formatError(token, "Expression")
+				message += "The number of operation rules must be at least 0."
 				panic(message)
 			default:
-				break parametersLoop
+				break operationsLoop
 			}
 		}
-		parameters.AppendValue(parameter)
+		operations.AppendValue(operation)
 	}
 
-	// Attempt to parse a single ")" delimiter.
-	_, token, ok = v.parseDelimiter(")")
+	// Found a single expression rule.
+	ruleFound_ = true
+	expression = ast.Expression().Make(
+		operand,
+		operations,
+	)
+	expression.SetSpan(v.getSpan(first_))
+	return expression, token, ruleFound_
+}
+
+func (v *parser_) parseField() (
+	field ast.FieldLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single name token.
+	var name string
+	name, token, ok = v.parseToken(NameToken)
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "FunctionMethod")
+			var message = v.formatError(token, "Field")
 			panic(message)
 		} else {
-			// This is not a single functionMethod rule.
-			return functionMethod, token, false
+			// This is not a single field rule.
+			return field, token, false
 		}
 	}
 	ruleFound_ = true
 
-	// Attempt to parse a single result rule.
-	var result ast.ResultLike
-	result, token, ok = v.parseResult()
+	// Attempt to parse a single abstraction rule.
+	var abstraction ast.AbstractionLike
+	abstraction, token, ok = v.parseAbstraction()
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "FunctionMethod")
+			var message = v.formatError(token, "Field")
 			panic(message)
 		} else {
-			// This is not a single functionMethod rule.
-			return functionMethod, token, false
+			// This is not a single field rule.
+			return field, token, false
 		}
 	}
 	ruleFound_ = true
 
-	// Found a single functionMethod rule.
+	// Attempt to parse an optional tag token.
+	var optionalTag string
+	optionalTag, _, ok = v.parseToken(TagToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Found a single field rule.
 	ruleFound_ = true
-	functionMethod = ast.FunctionMethod().Make(
+	field = ast.Field().Make(
 		name,
-		parameters,
-		result,
+		abstraction,
+		optionalTag,
 	)
-	return functionMethod, token, ruleFound_
+	field.SetSpan(v.getSpan(first_))
+	return field, token, ruleFound_
 }
 
-func (v *parser_) parseFunctionSubsection() (
-	functionSubsection ast.FunctionSubsectionLike,
+func (v *parser_) parseFunction() (
+	function ast.FunctionLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
-	// Attempt to parse a single "// Function Methods" delimiter.
-	_, token, ok = v.parseDelimiter("// Function Methods")
+	// Attempt to parse a single "func" delimiter.
+	_, token, ok = v.parseDelimiter("func")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "FunctionSubsection")
+			var message = v.formatError(token, "Function")
 			panic(message)
 		} else {
-			// This is not a single functionSubsection rule.
-			return functionSubsection, token, false
-		}
-	}
-	ruleFound_ = true
-
-	// Attempt to parse 1 to unlimited functionMethod rules.
-	var functionMethods = col.List[ast.FunctionMethodLike]()
-functionMethodsLoop:
-	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
-		var functionMethod ast.FunctionMethodLike
-		functionMethod, token, ok = v.parseFunctionMethod()
-		if !ok {
-			switch {
-			case numberFound_ < 1:
-				if !ruleFound_ {
-					// This is not a single functionSubsection rule.
-					return functionSubsection, token, false
-				}
-				// Found a syntax error.
-				var message = v.formatError(token, "FunctionSubsection")
-				message += "The number of functionMethod rules must be at least 1."
-				panic(message)
-			default:
-				break functionMethodsLoop
-			}
+			// This is not a single function rule.
+			return function, token, false
 		}
-		functionMethods.AppendValue(functionMethod)
 	}
-
-	// Found a single functionSubsection rule.
 	ruleFound_ = true
-	functionSubsection = ast.FunctionSubsection().Make(functionMethods)
-	return functionSubsection, token, ruleFound_
-}
-
-func (v *parser_) parseFunctionalDefinition() (
-	functionalDefinition ast.FunctionalDefinitionLike,
-	token TokenLike,
-	ok bool,
-) {
-	var ruleFound_ bool
 
-	// Attempt to parse a single declaration rule.
-	var declaration ast.DeclarationLike
-	declaration, token, ok = v.parseDeclaration()
+	// Attempt to parse a single "(" delimiter.
+	_, token, ok = v.parseDelimiter("(")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "FunctionalDefinition")
+			var message = v.formatError(token, "Function")
 			panic(message)
 		} else {
-			// This is not a single functional rule.
+			// This is not a single function rule.
+			return function, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional argument rule.
+	var optionalArgument ast.ArgumentLike
+	var additionalArguments = col.List[ast.AdditionalArgumentLike]()
+	optionalArgument, _, ok = v.parseArgument()
+	if ok {
+		ruleFound_ = true
+
+		// Attempt to parse 0 to unlimited additionalArgument rules that may only
+		// follow the first argument rule.
+	additionalArgumentsLoop:
+		for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+			var additionalArgument ast.AdditionalArgumentLike
+			additionalArgument, token, ok = v.parseAdditionalArgument()
+			if !ok {
+				switch {
+				case numberFound_ < 0:
+					if !ruleFound_ {
+						// This is not a single function rule.
+						return function, token, false
+					}
+					// Found a syntax error.
+					var message = v.formatError(token, "Function")
+					message += "The number of additionalArgument rules must be at least 0."
+					panic(message)
+				default:
+					break additionalArgumentsLoop
+				}
+			}
+			additionalArguments.AppendValue(additionalArgument)
+		}
+	}
+
+	// Attempt to parse a single ")" delimiter.
+	_, token, ok = v.parseDelimiter(")")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Function")
+			if uti.IsDefined(optionalArgument) && token.GetType() == NameToken {
+				message += "The arguments of an inline function may not be named."
+			}
+			panic(message)
+		} else {
+			// This is not a single function rule.
+			return function, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional result rule.
+	var optionalResult ast.ResultLike
+	optionalResult, _, ok = v.parseResult()
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Found a single function rule.
+	ruleFound_ = true
+	function = ast.Function().Make(
+		optionalArgument,
+		additionalArguments,
+		optionalResult,
+	)
+	function.SetSpan(v.getSpan(first_))
+	return function, token, ruleFound_
+}
+
+func (v *parser_) parseFunctionMethod() (
+	functionMethod ast.FunctionMethodLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse a single name token.
+	var name string
+	name, token, ok = v.parseToken(NameToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "FunctionMethod")
+			panic(message)
+		} else {
+			// This is not a single functionMethod rule.
+			return functionMethod, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single "(" delimiter.
+	_, token, ok = v.parseDelimiter("(")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "FunctionMethod")
+			panic(message)
+		} else {
+			// This is not a single functionMethod rule.
+			return functionMethod, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse 0 to unlimited parameter rules.
+	var parameters = col.List[ast.ParameterLike]()
+parametersLoop:
+	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+		var parameter ast.ParameterLike
+		parameter, token, ok = v.parseParameter()
+		if !ok {
+			switch {
+			case numberFound_ < 0:
+				if !ruleFound_ {
+					// This is not a single functionMethod rule.
+					return functionMethod, token, false
+				}
+				// Found a syntax error.
+				var message = v.formatError(token, "FunctionMethod")
+				message += "The number of parameter rules must be at least 0."
+				panic(message)
+			default:
+				break parametersLoop
+			}
+		}
+		parameters.AppendValue(parameter)
+	}
+
+	// Attempt to parse a single ")" delimiter.
+	_, token, ok = v.parseDelimiter(")")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "FunctionMethod")
+			panic(message)
+		} else {
+			// This is not a single functionMethod rule.
+			return functionMethod, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single result rule.
+	var result ast.ResultLike
+	result, token, ok = v.parseResult()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "FunctionMethod")
+			panic(message)
+		} else {
+			// This is not a single functionMethod rule.
+			return functionMethod, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single functionMethod rule.
+	ruleFound_ = true
+	functionMethod = ast.FunctionMethod().Make(
+		optionalNote,
+		name,
+		parameters,
+		result,
+	)
+	functionMethod.SetSpan(v.getSpan(first_))
+	return functionMethod, token, ruleFound_
+}
+
+func (v *parser_) parseFunctionSubsection() (
+	functionSubsection ast.FunctionSubsectionLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "// Function Methods" delimiter.
+	_, token, ok = v.parseDelimiter("// Function Methods")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "FunctionSubsection")
+			panic(message)
+		} else {
+			// This is not a single functionSubsection rule.
+			return functionSubsection, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse 1 to unlimited functionMethod rules.
+	var functionMethods = col.List[ast.FunctionMethodLike]()
+functionMethodsLoop:
+	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+		var functionMethod ast.FunctionMethodLike
+		functionMethod, token, ok = v.parseFunctionMethod()
+		if !ok {
+			switch {
+			case numberFound_ < 1:
+				if !ruleFound_ {
+					// This is not a single functionSubsection rule.
+					return functionSubsection, token, false
+				}
+				// Found a syntax error.
+				var message = v.formatError(token, "FunctionSubsection")
+				message += "The number of functionMethod rules must be at least 1."
+				panic(message)
+			default:
+				break functionMethodsLoop
+			}
+		}
+		functionMethods.AppendValue(functionMethod)
+	}
+
+	// Found a single functionSubsection rule.
+	ruleFound_ = true
+	functionSubsection = ast.FunctionSubsection().Make(functionMethods)
+	functionSubsection.SetSpan(v.getSpan(first_))
+	return functionSubsection, token, ruleFound_
+}
+
+func (v *parser_) parseFunctionalDefinition() (
+	functionalDefinition ast.FunctionalDefinitionLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single declaration rule.
+	var declaration ast.DeclarationLike
+	declaration, token, ok = v.parseDeclaration()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "FunctionalDefinition")
+			panic(message)
+		} else {
+			// This is not a single functional rule.
 			return functionalDefinition, token, false
 		}
 	}
@@ -1804,6 +2719,7 @@ parametersLoop:
 		parameters,
 		result,
 	)
+	functionalDefinition.SetSpan(v.getSpan(first_))
 	return functionalDefinition, token, ruleFound_
 }
 
@@ -1812,6 +2728,7 @@ func (v *parser_) parseFunctionalSection() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "// Functional Definitions" delimiter.
@@ -1829,11 +2746,12 @@ func (v *parser_) parseFunctionalSection() (
 	ruleFound_ = true
 
 	// Attempt to parse 1 to unlimited functional rules.
+	var errorsFound_ = v.errorsFound()
 	var functionalDefinitions = col.List[ast.FunctionalDefinitionLike]()
 functionalDefinitionsLoop:
 	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
 		var functionalDefinition ast.FunctionalDefinitionLike
-		functionalDefinition, token, ok = v.parseFunctionalDefinition()
+		functionalDefinition, token, ok = parseDefinition(v, v.parseFunctionalDefinition)
 		if !ok {
 			switch {
 			case numberFound_ < 1:
@@ -1841,6 +2759,10 @@ functionalDefinitionsLoop:
 					// This is not a single functionalSection rule.
 					return functionalSection, token, false
 				}
+				if v.errorsFound() > errorsFound_ {
+					// The syntax errors have already been recorded.
+					break functionalDefinitionsLoop
+				}
 				// Found a syntax error.
 				var message = v.formatError(token, "FunctionalSection")
 				message += "The number of functional rules must be at least 1."
@@ -1871,6 +2793,7 @@ functionalDefinitionsLoop:
 	// Found a single functionalSection rule.
 	ruleFound_ = true
 	functionalSection = ast.FunctionalSection().Make(functionalDefinitions)
+	functionalSection.SetSpan(v.getSpan(first_))
 	return functionalSection, token, ruleFound_
 }
 
@@ -1879,8 +2802,15 @@ func (v *parser_) parseGetterMethod() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
-	var tokens_ = col.List[TokenLike]()
+
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, _ = v.parseToken(NoteToken)
+
+	// NOTE: A getter method and a setter method both begin with a name and a "("
+	// delimiter so the rule is not committed to until the ")" delimiter is found.
 
 	// Attempt to parse a single name token.
 	var name string
@@ -1892,15 +2822,10 @@ func (v *parser_) parseGetterMethod() (
 			panic(message)
 		} else {
 			// This is not a single getterMethod rule.
-			var iterator = tokens_.GetIterator()
-			for iterator.ToEnd(); iterator.HasPrevious(); {
-				token = iterator.GetPrevious()
-				v.putBack(token)
-			}
+			v.backtrack(first_)
 			return getterMethod, token, false
 		}
 	}
-	tokens_.AppendValue(token)
 
 	// Attempt to parse a single "(" delimiter.
 	_, token, ok = v.parseDelimiter("(")
@@ -1911,15 +2836,10 @@ func (v *parser_) parseGetterMethod() (
 			panic(message)
 		} else {
 			// This is not a single getterMethod rule.
-			var iterator = tokens_.GetIterator()
-			for iterator.ToEnd(); iterator.HasPrevious(); {
-				token = iterator.GetPrevious()
-				v.putBack(token)
-			}
+			v.backtrack(first_)
 			return getterMethod, token, false
 		}
 	}
-	tokens_.AppendValue(token)
 
 	// Attempt to parse a single ")" delimiter.
 	_, token, ok = v.parseDelimiter(")")
@@ -1930,15 +2850,11 @@ func (v *parser_) parseGetterMethod() (
 			panic(message)
 		} else {
 			// This is not a single getterMethod rule.
-			var iterator = tokens_.GetIterator()
-			for iterator.ToEnd(); iterator.HasPrevious(); {
-				token = iterator.GetPrevious()
-				v.putBack(token)
-			}
+			v.backtrack(first_)
 			return getterMethod, token, false
 		}
 	}
-	tokens_.AppendValue(token)
+	ruleFound_ = true
 
 	// Attempt to parse a single abstraction rule.
 	var abstraction ast.AbstractionLike
@@ -1950,11 +2866,7 @@ func (v *parser_) parseGetterMethod() (
 			panic(message)
 		} else {
 			// This is not a single getterMethod rule.
-			var iterator = tokens_.GetIterator()
-			for iterator.ToEnd(); iterator.HasPrevious(); {
-				token = iterator.GetPrevious()
-				v.putBack(token)
-			}
+			v.backtrack(first_)
 			return getterMethod, token, false
 		}
 	}
@@ -1962,9 +2874,11 @@ func (v *parser_) parseGetterMethod() (
 
 	// Found a single getterMethod rule.
 	getterMethod = ast.GetterMethod().Make(
+		optionalNote,
 		name,
 		abstraction,
 	)
+	getterMethod.SetSpan(v.getSpan(first_))
 	return getterMethod, token, ruleFound_
 }
 
@@ -1973,6 +2887,7 @@ func (v *parser_) parseHeader() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single comment token.
@@ -2025,6 +2940,7 @@ func (v *parser_) parseHeader() (
 		comment,
 		name,
 	)
+	header.SetSpan(v.getSpan(first_))
 	return header, token, ruleFound_
 }
 
@@ -2033,6 +2949,7 @@ func (v *parser_) parseImports() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "import" delimiter.
@@ -2104,14 +3021,60 @@ modulesLoop:
 	// Found a single imports rule.
 	ruleFound_ = true
 	imports = ast.Imports().Make(modules)
+	imports.SetSpan(v.getSpan(first_))
 	return imports, token, ruleFound_
 }
 
+func (v *parser_) parseInitializer() (
+	initializer ast.InitializerLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "=" delimiter.
+	_, token, ok = v.parseDelimiter("=")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Initializer")
+			panic(message)
+		} else {
+			// This is not a single initializer rule.
+			return initializer, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single expression rule.
+	var expression ast.ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Initializer")
+			panic(message)
+		} else {
+			// This is not a single initializer rule.
+			return initializer, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single initializer rule.
+	ruleFound_ = true
+	initializer = ast.Initializer().Make(expression)
+	initializer.SetSpan(v.getSpan(first_))
+	return initializer, token, ruleFound_
+}
+
 func (v *parser_) parseInstanceDefinition() (
 	instanceDefinition ast.InstanceDefinitionLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single declaration rule.
@@ -2192,6 +3155,7 @@ func (v *parser_) parseInstanceDefinition() (
 		declaration,
 		instanceMethods,
 	)
+	instanceDefinition.SetSpan(v.getSpan(first_))
 	return instanceDefinition, token, ruleFound_
 }
 
@@ -2200,6 +3164,7 @@ func (v *parser_) parseInstanceSection() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "// Instance Definitions" delimiter.
@@ -2217,11 +3182,12 @@ func (v *parser_) parseInstanceSection() (
 	ruleFound_ = true
 
 	// Attempt to parse 1 to unlimited instanceDefinition rules.
+	var errorsFound_ = v.errorsFound()
 	var instanceDefinitions = col.List[ast.InstanceDefinitionLike]()
 instanceDefinitionsLoop:
 	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
 		var instanceDefinition ast.InstanceDefinitionLike
-		instanceDefinition, token, ok = v.parseInstanceDefinition()
+		instanceDefinition, token, ok = parseDefinition(v, v.parseInstanceDefinition)
 		if !ok {
 			switch {
 			case numberFound_ < 1:
@@ -2229,6 +3195,10 @@ instanceDefinitionsLoop:
 					// This is not a single instanceSection rule.
 					return instanceSection, token, false
 				}
+				if v.errorsFound() > errorsFound_ {
+					// The syntax errors have already been recorded.
+					break instanceDefinitionsLoop
+				}
 				// Found a syntax error.
 				var message = v.formatError(token, "InstanceSection")
 				message += "The number of instanceDefinition rules must be at least 1."
@@ -2259,6 +3229,7 @@ instanceDefinitionsLoop:
 	// Found a single instanceSection rule.
 	ruleFound_ = true
 	instanceSection = ast.InstanceSection().Make(instanceDefinitions)
+	instanceSection.SetSpan(v.getSpan(first_))
 	return instanceSection, token, ruleFound_
 }
 
@@ -2267,6 +3238,7 @@ func (v *parser_) parseInstanceMethods() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single publicSubsection rule.
@@ -2305,6 +3277,7 @@ func (v *parser_) parseInstanceMethods() (
 		optionalAttributeSubsection,
 		optionalAspectSubsection,
 	)
+	instanceMethods.SetSpan(v.getSpan(first_))
 	return instanceMethods, token, ruleFound_
 }
 
@@ -2313,6 +3286,7 @@ func (v *parser_) parseInterfaceDefinitions() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single classSection rule.
@@ -2359,18 +3333,97 @@ func (v *parser_) parseInterfaceDefinitions() (
 		instanceSection,
 		optionalAspectSection,
 	)
+	interfaceDefinitions.SetSpan(v.getSpan(first_))
 	return interfaceDefinitions, token, ruleFound_
 }
 
-func (v *parser_) parseMap() (
-	map_ ast.MapLike,
+func (v *parser_) parseInvocation() (
+	invocation ast.InvocationLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
-	// Attempt to parse a single "map" delimiter.
-	_, token, ok = v.parseDelimiter("map")
+	// Attempt to parse a single "(" delimiter.
+	_, token, ok = v.parseDelimiter("(")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Invocation")
+			panic(message)
+		} else {
+			// This is not a single invocation rule.
+			return invocation, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional expression rule.
+	var optionalExpression ast.ExpressionLike
+	optionalExpression, _, ok = v.parseExpression()
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse 0 to unlimited additionalExpression rules.
+	var additionalExpressions = col.List[ast.AdditionalExpressionLike]()
+additionalExpressionsLoop:
+	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+		var additionalExpression ast.AdditionalExpressionLike
+		additionalExpression, token, ok = v.parseAdditionalExpression()
+		if !ok {
+			switch {
+			case numberFound_ < 0:
+				if !ruleFound_ {
+					// This is not a single invocation rule.
+					return invocation, token, false
+				}
+				// Found a syntax error.
+				var message = v.formatError(token, "Invocation")
+				message += "The number of additionalExpression rules must be at least 0."
+				panic(message)
+			default:
+				break additionalExpressionsLoop
+			}
+		}
+		additionalExpressions.AppendValue(additionalExpression)
+	}
+
+	// Attempt to parse a single ")" delimiter.
+	_, token, ok = v.parseDelimiter(")")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Invocation")
+			panic(message)
+		} else {
+			// This is not a single invocation rule.
+			return invocation, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single invocation rule.
+	ruleFound_ = true
+	invocation = ast.Invocation().Make(
+		optionalExpression,
+		additionalExpressions,
+	)
+	invocation.SetSpan(v.getSpan(first_))
+	return invocation, token, ruleFound_
+}
+
+func (v *parser_) parseMap() (
+	map_ ast.MapLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "map" delimiter.
+	_, token, ok = v.parseDelimiter("map")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
@@ -2429,6 +3482,7 @@ func (v *parser_) parseMap() (
 	// Found a single map rule.
 	ruleFound_ = true
 	map_ = ast.Map().Make(name)
+	map_.SetSpan(v.getSpan(first_))
 	return map_, token, ruleFound_
 }
 
@@ -2437,8 +3491,16 @@ func (v *parser_) parseMethod() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
 	// Attempt to parse a single name token.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
@@ -2516,10 +3578,12 @@ parametersLoop:
 	// Found a single method rule.
 	ruleFound_ = true
 	method = ast.Method().Make(
+		optionalNote,
 		name,
 		parameters,
 		optionalResult,
 	)
+	method.SetSpan(v.getSpan(first_))
 	return method, token, ruleFound_
 }
 
@@ -2528,6 +3592,7 @@ func (v *parser_) parseModel() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single moduleDefinition rule.
@@ -2582,6 +3647,7 @@ func (v *parser_) parseModel() (
 		primitiveDefinitions,
 		interfaceDefinitions,
 	)
+	model.SetSpan(v.getSpan(first_))
 	return model, token, ruleFound_
 }
 
@@ -2590,22 +3656,15 @@ func (v *parser_) parseModule() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
-	// Attempt to parse a single name token.
-	var name string
-	name, token, ok = v.parseToken(NameToken)
-	if !ok {
-		if ruleFound_ {
-			// Found a syntax error.
-			var message = v.formatError(token, "Module")
-			panic(message)
-		} else {
-			// This is not a single module rule.
-			return module, token, false
-		}
+	// Attempt to parse an optional alias rule.
+	var optionalAlias ast.AliasLike
+	optionalAlias, _, ok = v.parseAlias()
+	if ok {
+		ruleFound_ = true
 	}
-	ruleFound_ = true
 
 	// Attempt to parse a single path token.
 	var path string
@@ -2625,9 +3684,10 @@ func (v *parser_) parseModule() (
 	// Found a single module rule.
 	ruleFound_ = true
 	module = ast.Module().Make(
-		name,
+		optionalAlias,
 		path,
 	)
+	module.SetSpan(v.getSpan(first_))
 	return module, token, ruleFound_
 }
 
@@ -2636,6 +3696,7 @@ func (v *parser_) parseModuleDefinition() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single notice rule.
@@ -2682,14 +3743,54 @@ func (v *parser_) parseModuleDefinition() (
 		header,
 		optionalImports,
 	)
+	moduleDefinition.SetSpan(v.getSpan(first_))
 	return moduleDefinition, token, ruleFound_
 }
 
+func (v *parser_) parseUnary() (
+	unary ast.UnaryLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "+", "-" or "^" delimiter.
+	var operator string
+	operator, token, ok = v.parseDelimiter("+")
+	if !ok {
+		operator, token, ok = v.parseDelimiter("-")
+	}
+	if !ok {
+		operator, token, ok = v.parseDelimiter("^")
+	}
+	if !ok {
+		// This is not a single unary rule.
+		return unary, token, false
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single operand rule.
+	var operand ast.OperandLike
+	operand, token, ok = v.parseOperand()
+	if !ok {
+		// Found a syntax error.
+		var message = v.formatError(token, "Unary")
+		panic(message)
+	}
+
+	// Found a single unary rule.
+	unary = ast.Unary().Make(operator, operand)
+	unary.SetSpan(v.getSpan(first_))
+	return unary, token, ruleFound_
+}
+
 func (v *parser_) parseNone() (
 	none ast.NoneLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single newline token.
@@ -2710,6 +3811,7 @@ func (v *parser_) parseNone() (
 	// Found a single none rule.
 	ruleFound_ = true
 	none = ast.None().Make(newline)
+	none.SetSpan(v.getSpan(first_))
 	return none, token, ruleFound_
 }
 
@@ -2718,6 +3820,7 @@ func (v *parser_) parseNotice() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single comment token.
@@ -2738,14 +3841,303 @@ func (v *parser_) parseNotice() (
 	// Found a single notice rule.
 	ruleFound_ = true
 	notice = ast.Notice().Make(comment)
+	notice.SetSpan(v.getSpan(first_))
 	return notice, token, ruleFound_
 }
 
+func (v *parser_) parseOperand() (
+	operand ast.OperandLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	// Attempt to parse a single number token.
+	var number string
+	number, token, ok = v.parseToken(NumberToken)
+	if ok {
+		// Found a single number operand.
+		operand = ast.Operand().Make(number)
+		operand.SetSpan(v.getSpan(first_))
+		return operand, token, true
+	}
+
+	// Attempt to parse a single path token.
+	var path string
+	path, token, ok = v.parseToken(PathToken)
+	if ok {
+		// Found a single path operand.
+		operand = ast.Operand().Make(path)
+		operand.SetSpan(v.getSpan(first_))
+		return operand, token, true
+	}
+
+	// Attempt to parse a single rune token.
+	var rune_ string
+	rune_, token, ok = v.parseToken(RuneToken)
+	if ok {
+		// Found a single rune operand.
+		operand = ast.Operand().Make(rune_)
+		operand.SetSpan(v.getSpan(first_))
+		return operand, token, true
+	}
+
+	// Attempt to parse a single reference rule.
+	var reference ast.ReferenceLike
+	reference, token, ok = v.parseReference()
+	if ok {
+		// Found a single reference operand.
+		operand = ast.Operand().Make(reference)
+		operand.SetSpan(v.getSpan(first_))
+		return operand, token, true
+	}
+
+	// Attempt to parse a single "iota" delimiter.
+	var delimiter string
+	delimiter, token, ok = v.parseDelimiter("iota")
+	if ok {
+		// Found a single "iota" operand.
+		operand = ast.Operand().Make(delimiter)
+		operand.SetSpan(v.getSpan(first_))
+		return operand, token, true
+	}
+
+	// Attempt to parse a single unary rule.
+	var unary ast.UnaryLike
+	unary, token, ok = v.parseUnary()
+	if ok {
+		// Found a single unary operand.
+		operand = ast.Operand().Make(unary)
+		operand.SetSpan(v.getSpan(first_))
+		return operand, token, true
+	}
+
+	// Attempt to parse a single precedence rule.
+	var precedence ast.PrecedenceLike
+	precedence, token, ok = v.parsePrecedence()
+	if ok {
+		// Found a single precedence operand.
+		operand = ast.Operand().Make(precedence)
+		operand.SetSpan(v.getSpan(first_))
+		return operand, token, true
+	}
+
+	// This is not a single operand rule.
+	return operand, token, false
+}
+
+func (v *parser_) parseOperation() (
+	operation ast.OperationLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single operator rule.
+	var operator ast.OperatorLike
+	operator, token, ok = v.parseOperator()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Operation")
+			panic(message)
+		} else {
+			// This is not a single operation rule.
+			return operation, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single operand rule.
+	var operand ast.OperandLike
+	operand, token, ok = v.parseOperand()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Operation")
+			panic(message)
+		} else {
+			// This is not a single operation rule.
+			return operation, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single operation rule.
+	ruleFound_ = true
+	operation = ast.Operation().Make(
+		operator,
+		operand,
+	)
+	operation.SetSpan(v.getSpan(first_))
+	return operation, token, ruleFound_
+}
+
+func (v *parser_) parseOperator() (
+	operator ast.OperatorLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var delimiter string
+
+	// Attempt to parse a single "<<" delimiter.
+	delimiter, token, ok = v.parseDelimiter("<<")
+	if ok {
+		// Found a single "<<" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single ">>" delimiter.
+	delimiter, token, ok = v.parseDelimiter(">>")
+	if ok {
+		// Found a single ">>" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single "+" delimiter.
+	delimiter, token, ok = v.parseDelimiter("+")
+	if ok {
+		// Found a single "+" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single "-" delimiter.
+	delimiter, token, ok = v.parseDelimiter("-")
+	if ok {
+		// Found a single "-" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single "*" delimiter.
+	delimiter, token, ok = v.parseDelimiter("*")
+	if ok {
+		// Found a single "*" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single "/" delimiter.
+	delimiter, token, ok = v.parseDelimiter("/")
+	if ok {
+		// Found a single "/" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single "%" delimiter.
+	delimiter, token, ok = v.parseDelimiter("%")
+	if ok {
+		// Found a single "%" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single "&^" delimiter.
+	delimiter, token, ok = v.parseDelimiter("&^")
+	if ok {
+		// Found a single "&^" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single "|" delimiter.
+	delimiter, token, ok = v.parseDelimiter("|")
+	if ok {
+		// Found a single "|" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single "&" delimiter.
+	delimiter, token, ok = v.parseDelimiter("&")
+	if ok {
+		// Found a single "&" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// Attempt to parse a single "^" delimiter.
+	delimiter, token, ok = v.parseDelimiter("^")
+	if ok {
+		// Found a single "^" operator.
+		operator = ast.Operator().Make(delimiter)
+		operator.SetSpan(v.getSpan(first_))
+		return operator, token, true
+	}
+
+	// This is not a single operator rule.
+	return operator, token, false
+}
+
+func (v *parser_) parseOutput() (
+	output ast.OutputLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single name token.
+	var name string
+	name, token, ok = v.parseToken(NameToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Output")
+			panic(message)
+		} else {
+			// This is not a single output rule.
+			return output, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single abstraction rule.
+	var abstraction ast.AbstractionLike
+	abstraction, token, ok = v.parseAbstraction()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Output")
+			panic(message)
+		} else {
+			// This is not a single output rule.
+			return output, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single output rule.
+	ruleFound_ = true
+	output = ast.Output().Make(
+		name,
+		abstraction,
+	)
+	output.SetSpan(v.getSpan(first_))
+	return output, token, ruleFound_
+}
+
 func (v *parser_) parseParameter() (
 	parameter ast.ParameterLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single name token.
@@ -2763,6 +4155,13 @@ func (v *parser_) parseParameter() (
 	}
 	ruleFound_ = true
 
+	// Attempt to parse an optional "..." delimiter.
+	var variadic bool
+	_, _, ok = v.parseDelimiter("...")
+	if ok {
+		variadic = true
+	}
+
 	// Attempt to parse a single abstraction rule.
 	var abstraction ast.AbstractionLike
 	abstraction, token, ok = v.parseAbstraction()
@@ -2796,8 +4195,10 @@ func (v *parser_) parseParameter() (
 	ruleFound_ = true
 	parameter = ast.Parameter().Make(
 		name,
+		variadic,
 		abstraction,
 	)
+	parameter.SetSpan(v.getSpan(first_))
 	return parameter, token, ruleFound_
 }
 
@@ -2806,6 +4207,7 @@ func (v *parser_) parseParameterized() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "(" delimiter.
@@ -2822,28 +4224,46 @@ func (v *parser_) parseParameterized() (
 	}
 	ruleFound_ = true
 
-	// Attempt to parse 1 to unlimited parameter rules.
-	var parameters = col.List[ast.ParameterLike]()
-parametersLoop:
+	// Attempt to parse a single output rule.
+	var output ast.OutputLike
+	output, token, ok = v.parseOutput()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Parameterized")
+			panic(message)
+		} else {
+			// This is not a single parameterized rule.
+			return parameterized, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse 0 to unlimited additionalOutput rules.
+	var additionalOutputs = col.List[ast.AdditionalOutputLike]()
+additionalOutputsLoop:
 	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
-		var parameter ast.ParameterLike
-		parameter, token, ok = v.parseParameter()
+		if v.parseTrailingComma() {
+			break additionalOutputsLoop
+		}
+		var additionalOutput ast.AdditionalOutputLike
+		additionalOutput, token, ok = v.parseAdditionalOutput()
 		if !ok {
 			switch {
-			case numberFound_ < 1:
+			case numberFound_ < 0:
 				if !ruleFound_ {
 					// This is not a single parameterized rule.
 					return parameterized, token, false
 				}
 				// Found a syntax error.
 				var message = v.formatError(token, "Parameterized")
-				message += "The number of parameter rules must be at least 1."
+				message += "The number of additionalOutput rules must be at least 0."
 				panic(message)
 			default:
-				break parametersLoop
+				break additionalOutputsLoop
 			}
 		}
-		parameters.AppendValue(parameter)
+		additionalOutputs.AppendValue(additionalOutput)
 	}
 
 	// Attempt to parse a single ")" delimiter.
@@ -2862,23 +4282,97 @@ parametersLoop:
 
 	// Found a single parameterized rule.
 	ruleFound_ = true
-	parameterized = ast.Parameterized().Make(parameters)
+	parameterized = ast.Parameterized().Make(
+		output,
+		additionalOutputs,
+	)
+	parameterized.SetSpan(v.getSpan(first_))
 	return parameterized, token, ruleFound_
 }
 
-func (v *parser_) parsePrefix() (
-	prefix ast.PrefixLike,
+func (v *parser_) parsePointer() (
+	pointer ast.PointerLike,
 	token TokenLike,
 	ok bool,
 ) {
-	// Attempt to parse a single array rule.
-	var array ast.ArrayLike
-	array, token, ok = v.parseArray()
-	if ok {
-		// Found a single array prefix.
-		prefix = ast.Prefix().Make(array)
-		return prefix, token, true
-	}
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "*" delimiter.
+	_, token, ok = v.parseDelimiter("*")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Pointer")
+			panic(message)
+		} else {
+			// This is not a single pointer rule.
+			return pointer, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single pointer rule.
+	ruleFound_ = true
+	pointer = ast.Pointer().Make()
+	pointer.SetSpan(v.getSpan(first_))
+	return pointer, token, ruleFound_
+}
+
+func (v *parser_) parsePrecedence() (
+	precedence ast.PrecedenceLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "(" delimiter.
+	_, token, ok = v.parseDelimiter("(")
+	if !ok {
+		// This is not a single precedence rule.
+		return precedence, token, false
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single expression rule.
+	var expression ast.ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// Found a syntax error.
+		var message = v.formatError(token, "Precedence")
+		panic(message)
+	}
+
+	// Attempt to parse a single ")" delimiter.
+	_, token, ok = v.parseDelimiter(")")
+	if !ok {
+		// Found a syntax error.
+		var message = v.formatError(token, "Precedence")
+		panic(message)
+	}
+
+	// Found a single precedence rule.
+	precedence = ast.Precedence().Make(expression)
+	precedence.SetSpan(v.getSpan(first_))
+	return precedence, token, ruleFound_
+}
+
+func (v *parser_) parsePrefix() (
+	prefix ast.PrefixLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	// Attempt to parse a single array rule.
+	var array ast.ArrayLike
+	array, token, ok = v.parseArray()
+	if ok {
+		// Found a single array prefix.
+		prefix = ast.Prefix().Make(array)
+		prefix.SetSpan(v.getSpan(first_))
+		return prefix, token, true
+	}
 
 	// Attempt to parse a single map rule.
 	var map_ ast.MapLike
@@ -2886,6 +4380,7 @@ func (v *parser_) parsePrefix() (
 	if ok {
 		// Found a single map prefix.
 		prefix = ast.Prefix().Make(map_)
+		prefix.SetSpan(v.getSpan(first_))
 		return prefix, token, true
 	}
 
@@ -2895,6 +4390,17 @@ func (v *parser_) parsePrefix() (
 	if ok {
 		// Found a single channel prefix.
 		prefix = ast.Prefix().Make(channel)
+		prefix.SetSpan(v.getSpan(first_))
+		return prefix, token, true
+	}
+
+	// Attempt to parse a single pointer rule.
+	var pointer ast.PointerLike
+	pointer, token, ok = v.parsePointer()
+	if ok {
+		// Found a single pointer prefix.
+		prefix = ast.Prefix().Make(pointer)
+		prefix.SetSpan(v.getSpan(first_))
 		return prefix, token, true
 	}
 
@@ -2907,6 +4413,7 @@ func (v *parser_) parsePrimitiveDefinitions() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse an optional type section rule.
@@ -2923,12 +4430,21 @@ func (v *parser_) parsePrimitiveDefinitions() (
 		ruleFound_ = true
 	}
 
+	// Attempt to parse an optional constantSection rule.
+	var optionalConstantSection ast.ConstantSectionLike
+	optionalConstantSection, _, ok = v.parseConstantSection()
+	if ok {
+		ruleFound_ = true
+	}
+
 	// Found a single primitiveDefinitions rule.
 	ruleFound_ = true
 	primitiveDefinitions = ast.PrimitiveDefinitions().Make(
 		optionalTypeSection,
 		optionalFunctionalSection,
+		optionalConstantSection,
 	)
+	primitiveDefinitions.SetSpan(v.getSpan(first_))
 	return primitiveDefinitions, token, ruleFound_
 }
 
@@ -2937,6 +4453,7 @@ func (v *parser_) parsePublicMethod() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single method rule.
@@ -2959,6 +4476,7 @@ func (v *parser_) parsePublicMethod() (
 	publicMethod = ast.PublicMethod().Make(
 		method,
 	)
+	publicMethod.SetSpan(v.getSpan(first_))
 	return publicMethod, token, ruleFound_
 }
 
@@ -2967,6 +4485,7 @@ func (v *parser_) parsePublicSubsection() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "// Public Methods" delimiter.
@@ -3010,20 +4529,101 @@ publicMethodsLoop:
 	// Found a single publicSubsection rule.
 	ruleFound_ = true
 	publicSubsection = ast.PublicSubsection().Make(publicMethods)
+	publicSubsection.SetSpan(v.getSpan(first_))
 	return publicSubsection, token, ruleFound_
 }
 
+func (v *parser_) parseQualifier() (
+	qualifier ast.QualifierLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var delimiter string
+
+	// Attempt to parse a single "const" delimiter.
+	delimiter, token, ok = v.parseDelimiter("const")
+	if ok {
+		// Found a single "const" qualifier.
+		qualifier = ast.Qualifier().Make(delimiter)
+		qualifier.SetSpan(v.getSpan(first_))
+		return qualifier, token, true
+	}
+
+	// Attempt to parse a single "var" delimiter.
+	delimiter, token, ok = v.parseDelimiter("var")
+	if ok {
+		// Found a single "var" qualifier.
+		qualifier = ast.Qualifier().Make(delimiter)
+		qualifier.SetSpan(v.getSpan(first_))
+		return qualifier, token, true
+	}
+
+	// This is not a single qualifier rule.
+	return qualifier, token, false
+}
+
+func (v *parser_) parseReference() (
+	reference ast.ReferenceLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single name token.
+	var name string
+	name, token, ok = v.parseToken(NameToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Reference")
+			panic(message)
+		} else {
+			// This is not a single reference rule.
+			return reference, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional suffix rule.
+	var optionalSuffix ast.SuffixLike
+	optionalSuffix, _, ok = v.parseSuffix()
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse an optional invocation rule.
+	var optionalInvocation ast.InvocationLike
+	optionalInvocation, _, ok = v.parseInvocation()
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Found a single reference rule.
+	ruleFound_ = true
+	reference = ast.Reference().Make(
+		name,
+		optionalSuffix,
+		optionalInvocation,
+	)
+	reference.SetSpan(v.getSpan(first_))
+	return reference, token, ruleFound_
+}
+
 func (v *parser_) parseResult() (
 	result ast.ResultLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	// Attempt to parse a single none rule.
 	var none ast.NoneLike
 	none, token, ok = v.parseNone()
 	if ok {
 		// Found a single none result.
 		result = ast.Result().Make(none)
+		result.SetSpan(v.getSpan(first_))
 		return result, token, true
 	}
 
@@ -3033,6 +4633,17 @@ func (v *parser_) parseResult() (
 	if ok {
 		// Found a single abstraction result.
 		result = ast.Result().Make(abstraction)
+		result.SetSpan(v.getSpan(first_))
+		return result, token, true
+	}
+
+	// Attempt to parse a single tuple rule.
+	var tuple ast.TupleLike
+	tuple, token, ok = v.parseTuple()
+	if ok {
+		// Found a single tuple result.
+		result = ast.Result().Make(tuple)
+		result.SetSpan(v.getSpan(first_))
 		return result, token, true
 	}
 
@@ -3042,6 +4653,7 @@ func (v *parser_) parseResult() (
 	if ok {
 		// Found a single parameterized result.
 		result = ast.Result().Make(parameterized)
+		result.SetSpan(v.getSpan(first_))
 		return result, token, true
 	}
 
@@ -3055,8 +4667,16 @@ func (v *parser_) parseSetterMethod() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
 	// Attempt to parse a single name token.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
@@ -3117,17 +4737,133 @@ func (v *parser_) parseSetterMethod() (
 
 	// Found a single setterMethod rule.
 	setterMethod = ast.SetterMethod().Make(
+		optionalNote,
 		name,
 		parameter,
 	)
+	setterMethod.SetSpan(v.getSpan(first_))
 	return setterMethod, token, ruleFound_
 }
 
+func (v *parser_) parseSize() (
+	size ast.SizeLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	// Attempt to parse a single number token.
+	var number string
+	number, token, ok = v.parseToken(NumberToken)
+	if ok {
+		// Found a single number size.
+		size = ast.Size().Make(number)
+		size.SetSpan(v.getSpan(first_))
+		return size, token, true
+	}
+
+	// Attempt to parse a single name token.
+	var name string
+	name, token, ok = v.parseToken(NameToken)
+	if ok {
+		// Found a single name size.
+		size = ast.Size().Make(name)
+		size.SetSpan(v.getSpan(first_))
+		return size, token, true
+	}
+
+	// This is not a single size rule.
+	return size, token, false
+}
+
+func (v *parser_) parseStructure() (
+	structure ast.StructureLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse a single "struct" delimiter.
+	_, token, ok = v.parseDelimiter("struct")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Structure")
+			panic(message)
+		} else {
+			// This is not a single structure rule.
+			return structure, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single "{" delimiter.
+	_, token, ok = v.parseDelimiter("{")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Structure")
+			panic(message)
+		} else {
+			// This is not a single structure rule.
+			return structure, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse 1 to unlimited field rules.
+	var fields = col.List[ast.FieldLike]()
+fieldsLoop:
+	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+		var field ast.FieldLike
+		field, token, ok = v.parseField()
+		if !ok {
+			switch {
+			case numberFound_ < 1:
+				if !ruleFound_ {
+					// This is not a single structure rule.
+					return structure, token, false
+				}
+				// Found a syntax error.
+				var message = v.formatError(token, "Structure")
+				message += "The number of field rules must be at least 1."
+				panic(message)
+			default:
+				break fieldsLoop
+			}
+		}
+		fields.AppendValue(field)
+	}
+
+	// Attempt to parse a single "}" delimiter.
+	_, token, ok = v.parseDelimiter("}")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Structure")
+			panic(message)
+		} else {
+			// This is not a single structure rule.
+			return structure, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single structure rule.
+	ruleFound_ = true
+	structure = ast.Structure().Make(
+		fields,
+	)
+	structure.SetSpan(v.getSpan(first_))
+	return structure, token, ruleFound_
+}
+
 func (v *parser_) parseSuffix() (
 	suffix ast.SuffixLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "." delimiter.
@@ -3162,14 +4898,142 @@ func (v *parser_) parseSuffix() (
 	// Found a single suffix rule.
 	ruleFound_ = true
 	suffix = ast.Suffix().Make(name)
+	suffix.SetSpan(v.getSpan(first_))
 	return suffix, token, ruleFound_
 }
 
+func (v *parser_) parseTerm() (
+	term ast.TermLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// Attempt to parse an optional "~" delimiter.
+	var approximate bool
+	_, _, ok = v.parseDelimiter("~")
+	if ok {
+		approximate = true
+		ruleFound_ = true
+	}
+
+	// Attempt to parse a single abstraction rule.
+	var abstraction ast.AbstractionLike
+	abstraction, token, ok = v.parseAbstraction()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Term")
+			panic(message)
+		} else {
+			// This is not a single term rule.
+			return term, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single term rule.
+	ruleFound_ = true
+	term = ast.Term().Make(
+		approximate,
+		abstraction,
+	)
+	term.SetSpan(v.getSpan(first_))
+	return term, token, ruleFound_
+}
+
+func (v *parser_) parseTuple() (
+	tuple ast.TupleLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	var ruleFound_ bool
+
+	// NOTE: A tuple and a parameterized result both begin with a "(" delimiter
+	// and a name, but only the first argument of a tuple can be followed by a ","
+	// or ")" delimiter so the rule is not committed to until one of them is found.
+	// A trailing "," delimiter must be followed by the ")" delimiter so it is
+	// checked for before each additional argument.
+
+	// Attempt to parse a single "(" delimiter.
+	_, token, ok = v.parseDelimiter("(")
+	if !ok {
+		// This is not a single tuple rule.
+		return tuple, token, false
+	}
+
+	// Attempt to parse a single argument rule.
+	var argument ast.ArgumentLike
+	argument, token, ok = v.parseArgument()
+	if !ok {
+		// This is not a single tuple rule.
+		v.backtrack(first_)
+		return tuple, token, false
+	}
+
+	// Attempt to parse 0 to unlimited additionalArgument rules.
+	var additionalArguments = col.List[ast.AdditionalArgumentLike]()
+additionalArgumentsLoop:
+	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
+		if v.parseTrailingComma() {
+			ruleFound_ = true
+			break additionalArgumentsLoop
+		}
+		var additionalArgument ast.AdditionalArgumentLike
+		additionalArgument, token, ok = v.parseAdditionalArgument()
+		if !ok {
+			switch {
+			case numberFound_ < 0:
+				if !ruleFound_ {
+					// This is not a single tuple rule.
+					v.backtrack(first_)
+					return tuple, token, false
+				}
+				// Found a syntax error.
+				var message = v.formatError(token, "Tuple")
+				message += "The number of additionalArgument rules must be at least 0."
+				panic(message)
+			default:
+				break additionalArgumentsLoop
+			}
+		}
+		ruleFound_ = true
+		additionalArguments.AppendValue(additionalArgument)
+	}
+
+	// Attempt to parse a single ")" delimiter.
+	_, token, ok = v.parseDelimiter(")")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			var message = v.formatError(token, "Tuple")
+			panic(message)
+		} else {
+			// This is not a single tuple rule.
+			v.backtrack(first_)
+			return tuple, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single tuple rule.
+	ruleFound_ = true
+	tuple = ast.Tuple().Make(
+		argument,
+		additionalArguments,
+	)
+	tuple.SetSpan(v.getSpan(first_))
+	return tuple, token, ruleFound_
+}
+
 func (v *parser_) parseTypeDefinition() (
 	typeDefinition ast.TypeDefinitionLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single declaration rule.
@@ -3187,9 +5051,9 @@ func (v *parser_) parseTypeDefinition() (
 	}
 	ruleFound_ = true
 
-	// Attempt to parse a single abstraction rule.
-	var abstraction ast.AbstractionLike
-	abstraction, token, ok = v.parseAbstraction()
+	// Attempt to parse a single underlying rule.
+	var underlying ast.UnderlyingLike
+	underlying, token, ok = v.parseUnderlying()
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
@@ -3213,9 +5077,10 @@ func (v *parser_) parseTypeDefinition() (
 	ruleFound_ = true
 	typeDefinition = ast.TypeDefinition().Make(
 		declaration,
-		abstraction,
+		underlying,
 		optionalEnumeration,
 	)
+	typeDefinition.SetSpan(v.getSpan(first_))
 	return typeDefinition, token, ruleFound_
 }
 
@@ -3224,6 +5089,7 @@ func (v *parser_) parseTypeSection() (
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
 	// Attempt to parse a single "// Type Definitions" delimiter.
@@ -3241,11 +5107,12 @@ func (v *parser_) parseTypeSection() (
 	ruleFound_ = true
 
 	// Attempt to parse 1 to unlimited typeDefinition rules.
+	var errorsFound_ = v.errorsFound()
 	var typeDefinitions = col.List[ast.TypeDefinitionLike]()
 typeDefinitionsLoop:
 	for numberFound_ := 0; numberFound_ < v.getClass().unlimited_; numberFound_++ {
 		var typeDefinition ast.TypeDefinitionLike
-		typeDefinition, token, ok = v.parseTypeDefinition()
+		typeDefinition, token, ok = parseDefinition(v, v.parseTypeDefinition)
 		if !ok {
 			switch {
 			case numberFound_ < 1:
@@ -3253,6 +5120,10 @@ typeDefinitionsLoop:
 					// This is not a single typeSection rule.
 					return typeSection, token, false
 				}
+				if v.errorsFound() > errorsFound_ {
+					// The syntax errors have already been recorded.
+					break typeDefinitionsLoop
+				}
 				// Found a syntax error.
 				var message = v.formatError(token, "TypeSection")
 				message += "The number of typeDefinition rules must be at least 1."
@@ -3283,19 +5154,54 @@ typeDefinitionsLoop:
 	// Found a single typeSection rule.
 	ruleFound_ = true
 	typeSection = ast.TypeSection().Make(typeDefinitions)
+	typeSection.SetSpan(v.getSpan(first_))
 	return typeSection, token, ruleFound_
 }
 
+func (v *parser_) parseUnderlying() (
+	underlying ast.UnderlyingLike,
+	token TokenLike,
+	ok bool,
+) {
+	var first_ = len(v.history_)
+	// Attempt to parse a single structure rule.
+	var structure ast.StructureLike
+	structure, token, ok = v.parseStructure()
+	if ok {
+		// Found a single structure underlying.
+		underlying = ast.Underlying().Make(structure)
+		underlying.SetSpan(v.getSpan(first_))
+		return underlying, token, true
+	}
+
+	// Attempt to parse a single abstraction rule.
+	var abstraction ast.AbstractionLike
+	abstraction, token, ok = v.parseAbstraction()
+	if ok {
+		// Found a single abstraction underlying.
+		underlying = ast.Underlying().Make(abstraction)
+		underlying.SetSpan(v.getSpan(first_))
+		return underlying, token, true
+	}
+
+	// This is not a single underlying rule.
+	return underlying, token, false
+}
+
 func (v *parser_) parseValue() (
 	value ast.ValueLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var first_ = len(v.history_)
 	var ruleFound_ bool
 
-	// Attempt to parse a single name token.
+	// Attempt to parse a single name token or "_" delimiter.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
+	if !ok {
+		name, token, ok = v.parseDelimiter("_")
+	}
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
@@ -3323,22 +5229,9 @@ func (v *parser_) parseValue() (
 	}
 	ruleFound_ = true
 
-	// Attempt to parse a single "=" delimiter.
-	_, token, ok = v.parseDelimiter("=")
-	if !ok {
-		if ruleFound_ {
-			// Found a syntax error.
-			var message = v.formatError(token, "Value")
-			panic(message)
-		} else {
-			// This is not a single value rule.
-			return value, token, false
-		}
-	}
-	ruleFound_ = true
-
-	// Attempt to parse a single "iota" delimiter.
-	_, token, ok = v.parseDelimiter("iota")
+	// Attempt to parse a single initializer rule.
+	var initializer ast.InitializerLike
+	initializer, token, ok = v.parseInitializer()
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
@@ -3356,7 +5249,9 @@ func (v *parser_) parseValue() (
 	value = ast.Value().Make(
 		name,
 		abstraction,
+		initializer,
 	)
+	value.SetSpan(v.getSpan(first_))
 	return value, token, ruleFound_
 }
 
@@ -3379,6 +5274,24 @@ func (v *parser_) parseDelimiter(expectedValue string) (
 	return value, token, false
 }
 
+func (v *parser_) parseTrailingComma() bool {
+	// Attempt to parse a "," delimiter that is followed by a ")" delimiter.
+	var first_ = len(v.history_)
+	var _, _, ok = v.parseDelimiter(",")
+	if !ok {
+		return false
+	}
+	_, _, ok = v.parseDelimiter(")")
+	v.backtrack(first_)
+	if !ok {
+		return false
+	}
+
+	// Only the "," delimiter is accepted.
+	v.parseDelimiter(",")
+	return true
+}
+
 func (v *parser_) parseToken(tokenType TokenType) (
 	value string,
 	token TokenLike,
@@ -3391,6 +5304,7 @@ func (v *parser_) parseToken(tokenType TokenType) (
 		switch token.GetType() {
 		case tokenType:
 			// Found the right token type.
+			v.history_ = append(v.history_, token)
 			value = token.GetValue()
 			return value, token, true
 		case SpaceToken, NewlineToken:
@@ -3408,27 +5322,52 @@ func (v *parser_) parseToken(tokenType TokenType) (
 }
 
 func (v *parser_) formatError(token TokenLike, ruleName string) string {
-	// Format the error message.
-	var message = fmt.Sprintf(
-		"An unexpected token was received by the parser: %v\n",
-		Scanner().FormatToken(token),
-	)
-	var line = token.GetLine()
+	// Determine where in the source the error occurred.
 	var lines = sts.Split(v.source_, "\n")
+	var line = uint(len(lines))
+	var position = uint(len([]rune(lines[line-1]))) + 1
+	if uti.IsDefined(token) {
+		line = token.GetLine()
+		position = token.GetPosition()
+	}
+
+	// Record the details of the error.
+	var definition string
+	if uti.IsDefined(ruleName) {
+		definition = v.getDefinition(ruleName)
+	}
+	v.error_ = SyntaxError().Make(
+		line,
+		position,
+		token,
+		ruleName,
+		definition,
+	)
+
+	// Format the error message.
+	var message string
+	if uti.IsDefined(token) {
+		message = fmt.Sprintf(
+			"An unexpected token was received by the parser: %v\n",
+			Scanner().FormatToken(token),
+		)
+	} else {
+		message = "The end of the source was reached unexpectedly by the parser.\n"
+	}
 
 	// Append the source lines with the error in it.
 	message += "\033[36m"
 	for index := line - 3; index < line; index++ {
 		if index > 1 {
-			message += fmt.Sprintf("%04d: ", index) + string(lines[index-1]) + "\n"
+			message += fmt.Sprintf("%04d: ", index) + v.expandTabs(lines[index-1]) + "\n"
 		}
 	}
-	message += fmt.Sprintf("%04d: ", line) + string(lines[line-1]) + "\n"
+	message += fmt.Sprintf("%04d: ", line) + v.expandTabs(lines[line-1]) + "\n"
 
 	// Append an arrow pointing to the error.
 	message += " \033[32m>>>─"
 	var count uint
-	for count < token.GetPosition() {
+	for count < position {
 		message += "─"
 		count++
 	}
@@ -3436,7 +5375,7 @@ func (v *parser_) formatError(token TokenLike, ruleName string) string {
 
 	// Append the following source line for context.
 	if line < uint(len(lines)) {
-		message += fmt.Sprintf("%04d: ", line+1) + string(lines[line]) + "\n"
+		message += fmt.Sprintf("%04d: ", line+1) + v.expandTabs(lines[line]) + "\n"
 	}
 	message += "\033[0m\n"
 	if uti.IsDefined(ruleName) {
@@ -3444,12 +5383,30 @@ func (v *parser_) formatError(token TokenLike, ruleName string) string {
 		message += fmt.Sprintf(
 			"  \033[32m%v: \033[33m%v\033[0m\n\n",
 			ruleName,
-			v.getDefinition(ruleName),
+			definition,
 		)
 	}
 	return message
 }
 
+func (v *parser_) expandTabs(line string) string {
+	// Replace each tab with spaces so that the visual columns line up.
+	var tabSize = Scanner().TabSize()
+	var builder sts.Builder
+	var column uint = 1
+	for _, character := range line {
+		if character == '\t' {
+			var next = ((column-1)/tabSize+1)*tabSize + 1
+			builder.WriteString(sts.Repeat(" ", int(next-column)))
+			column = next
+		} else {
+			builder.WriteRune(character)
+			column++
+		}
+	}
+	return builder.String()
+}
+
 func (v *parser_) getDefinition(ruleName string) string {
 	return v.getClass().syntax_.GetValue(ruleName)
 }
@@ -3460,10 +5417,10 @@ func (v *parser_) getNextToken() TokenLike {
 		return v.next_.RemoveTop()
 	}
 
-	// Read a new token from the token stream.
-	var token, ok = v.tokens_.RemoveHead() // This will wait for a token.
-	if !ok {
-		// The token channel has been closed.
+	// Scan a new token from the source.
+	var token = v.scanner_.ScanToken()
+	if token == nil {
+		// The end of the source has been reached.
 		return nil
 	}
 
@@ -3476,26 +5433,253 @@ func (v *parser_) getNextToken() TokenLike {
 	return token
 }
 
+func (v *parser_) errorsFound() int {
+	if v.errors_ == nil {
+		// The parser is not recovering from syntax errors.
+		return 0
+	}
+	return v.errors_.GetSize()
+}
+
+func (v *parser_) synchronize() {
+	// Skip tokens until the end of the current definition or section.
+	for {
+		// An error token is reported rather than skipped since the scanner
+		// stops there.
+		var token = v.getNextToken()
+		if uti.IsUndefined(token) {
+			// We are at the end-of-file marker.
+			return
+		}
+		switch token.GetType() {
+		case CommentToken:
+			// The next definition is starting.
+			v.putBack(token)
+			return
+		case DelimiterToken:
+			switch token.GetValue() {
+			case "}":
+				// The current interface definition has ended.
+				return
+			case "// Type Definitions",
+				"// Functional Definitions",
+				"// Constant Definitions",
+				"// Class Definitions",
+				"// Instance Definitions",
+				"// Aspect Definitions":
+				// The next section is starting.
+				v.putBack(token)
+				return
+			}
+		}
+	}
+}
+
+func (v *parser_) getSpan(first int) ast.SpanLike {
+	// Determine the tokens that were accepted for the rule.
+	var last = len(v.history_) - 1
+	if first > last {
+		// No tokens were accepted.
+		return nil
+	}
+	var firstToken = v.history_[first]
+	var lastToken = v.history_[last]
+
+	// The span starts at the first token.
+	var start = ast.Location().Make(
+		firstToken.GetLine(),
+		firstToken.GetPosition(),
+		firstToken.GetOffset(),
+	)
+
+	// The span ends just past the last token.
+	var value = lastToken.GetValue()
+	var line = lastToken.GetLine()
+	var column = lastToken.GetPosition()
+	var tabSize = Scanner().TabSize()
+	for _, character := range value {
+		switch character {
+		case '\n':
+			line++
+			column = 1
+		case '\t':
+			column = ((column-1)/tabSize+1)*tabSize + 1
+		default:
+			column++
+		}
+	}
+	var end = ast.Location().Make(
+		line,
+		column,
+		lastToken.GetOffset()+uint(len(value)),
+	)
+	return ast.Span().Make(start, end)
+}
+
+func (v *parser_) backtrack(first int) {
+	// Return each token accepted since the first one to the token stream.
+	for last := len(v.history_) - 1; last >= first; last-- {
+		v.putBack(v.history_[last])
+	}
+}
+
 func (v *parser_) putBack(token TokenLike) {
+	// Remove the token from the accepted tokens if necessary.
+	var last = len(v.history_) - 1
+	if last >= 0 && v.history_[last] == token {
+		v.history_ = v.history_[:last]
+	}
 	v.next_.AddValue(token)
 }
 
+func (v *parser_) findModule(directory string) (
+	root string,
+	modulePath string,
+) {
+	// Search the directory and each of its parents for a "go.mod" file.
+	var err error
+	root, err = fil.Abs(directory)
+	if err != nil {
+		panic(err)
+	}
+	for {
+		var bytes, err = osx.ReadFile(fil.Join(root, "go.mod"))
+		if err == nil {
+			for _, line := range sts.Split(string(bytes), "\n") {
+				var fields = sts.Fields(line)
+				if len(fields) == 2 && fields[0] == "module" {
+					modulePath = sts.Trim(fields[1], `"`)
+					return root, modulePath
+				}
+			}
+		}
+		var parent = fil.Dir(root)
+		if parent == root {
+			var message = fmt.Sprintf(
+				"The directory is not contained in a Go module: %v",
+				directory,
+			)
+			panic(message)
+		}
+		root = parent
+	}
+}
+
+func (v *parser_) findModels(directory string) []string {
+	// Find the model file of each package in the directory tree.
+	var filenames []string
+	var err = fil.WalkDir(
+		directory,
+		func(path string, entry fsx.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			var name = entry.Name()
+			if entry.IsDir() {
+				if path == directory {
+					return nil
+				}
+				// Skip the directories that the go tool ignores.
+				if sts.HasPrefix(name, ".") || sts.HasPrefix(name, "_") ||
+					name == "testdata" {
+					return fil.SkipDir
+				}
+				// Skip any nested module since its packages are not part of
+				// this module.
+				var _, err = osx.Stat(fil.Join(path, "go.mod"))
+				if err == nil {
+					return fil.SkipDir
+				}
+				return nil
+			}
+			if name == "Package.go" {
+				filenames = append(filenames, path)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return filenames
+}
+
+func (v *parser_) extractPackagePath(
+	root string,
+	modulePath string,
+	filename string,
+) string {
+	var directory, err = fil.Abs(fil.Dir(filename))
+	if err != nil {
+		panic(err)
+	}
+	var relative, _ = fil.Rel(root, directory)
+	if relative == "." {
+		return modulePath
+	}
+	return modulePath + "/" + fil.ToSlash(relative)
+}
+
+// NOTE: Go does not support generic methods so this must be a function.
+func parseDefinition[T any](
+	v *parser_,
+	parse func() (T, TokenLike, bool),
+) (
+	definition T,
+	token TokenLike,
+	ok bool,
+) {
+	if v.errors_ == nil {
+		// The parser is not recovering from syntax errors.
+		return parse()
+	}
+	for {
+		// Attempt to parse the next definition, recovering from any syntax error.
+		var recovered bool
+		func() {
+			defer func() {
+				if e := recover(); e != nil {
+					if uti.IsUndefined(v.error_) {
+						// This is not a syntax error so pass it on.
+						panic(e)
+					}
+					var token = v.error_.GetOptionalToken()
+					if uti.IsDefined(token) && token.GetType() == ErrorToken {
+						// The scanner has stopped so no more tokens will follow.
+						panic(e)
+					}
+					v.errors_.AppendValue(v.error_)
+					v.error_ = nil
+					v.synchronize()
+					recovered = true
+				}
+			}()
+			definition, token, ok = parse()
+		}()
+		if !recovered {
+			return definition, token, ok
+		}
+	}
+}
+
 // PRIVATE INTERFACE
 
 // Instance Structure
 
 type parser_ struct {
 	// Declare the instance attributes.
-	source_ string                   // The original source code.
-	tokens_ abs.QueueLike[TokenLike] // A queue of unread tokens from the scanner.
-	next_   abs.StackLike[TokenLike] // A stack of read, but unprocessed tokens.
+	source_  string                        // The original source code.
+	scanner_ ScannerLike                   // The scanner of unread tokens from the source.
+	next_    abs.StackLike[TokenLike]      // A stack of read, but unprocessed tokens.
+	error_   SyntaxErrorLike               // The most recent syntax error, if any.
+	errors_  abs.ListLike[SyntaxErrorLike] // The recovered syntax errors, if recovering.
+	history_ []TokenLike                   // The tokens that have been accepted by the rules so far.
 }
 
 // Class Structure
 
 type parserClass_ struct {
 	// Declare the class constants.
-	queueSize_ uint
 	stackSize_ uint
 	unlimited_ int
 	syntax_    abs.CatalogLike[string, string]
@@ -3509,77 +5693,136 @@ func parserReference() *parserClass_ {
 
 var parserReference_ = &parserClass_{
 	// Initialize the class constants.
-	queueSize_: 16,
-	stackSize_: 4,
+	stackSize_: 16,
 	unlimited_: 4294967295, // Default to a reasonable value.
 	syntax_: col.Catalog[string, string](
 		map[string]string{
 			"Model":                `ModuleDefinition PrimitiveDefinitions InterfaceDefinitions`,
 			"ModuleDefinition":     `Notice Header Imports?`,
-			"PrimitiveDefinitions": `TypeSection? FunctionalSection?`,
+			"PrimitiveDefinitions": `TypeSection? FunctionalSection? ConstantSection?`,
 			"InterfaceDefinitions": `ClassSection InstanceSection AspectSection?`,
 			"Notice":               `comment`,
 			"Header":               `comment "package" name`,
 			"Imports":              `"import" "(" Module+ ")"`,
-			"Module":               `name path`,
+			"Module":               `Alias? path`,
+			"Alias": `
+  - name
+  - "."
+  - "_"
+`,
 			"TypeSection":          `"// Type Definitions" TypeDefinition+`,
-			"TypeDefinition":       `Declaration Abstraction Enumeration?`,
+			"TypeDefinition":       `Declaration Underlying Enumeration?`,
 			"Declaration":          `comment "type" name Constraints?`,
 			"Constraints":          `"[" Constraint AdditionalConstraint* "]"`,
-			"Constraint":           `name Abstraction`,
+			"Constraint":           `name Term AdditionalTerm*`,
+			"Term":                 `"~"? Abstraction`,
+			"AdditionalTerm":       `"|" Term`,
 			"AdditionalConstraint": `"," Constraint`,
-			"Abstraction":          `Prefix? name Suffix? Arguments?`,
+			"Underlying": `
+  - Structure
+  - Abstraction
+`,
+			"Structure":   `"struct" "{" Field+ "}"`,
+			"Field":       `name Abstraction tag?`,
+			"Abstraction": `Prefix* (Function | name Suffix? Arguments?)`,
 			"Prefix": `
   - Array
   - Map
   - Channel
+  - Pointer
+`,
+			"Array": `"[" Size? "]"`,
+			"Size": `
+  - number
+  - name
 `,
-			"Array":                `"[" "]"`,
-			"Map":                  `"map" "[" name "]"`,
-			"Channel":              `"chan"`,
-			"Suffix":               `"." name`,
-			"Arguments":            `"[" Argument AdditionalArgument* "]"`,
-			"Argument":             `Abstraction`,
-			"AdditionalArgument":   `"," Argument`,
-			"Enumeration":          `"const" "(" Value AdditionalValue* ")"`,
-			"Value":                `name Abstraction "=" "iota"`,
-			"AdditionalValue":      `name`,
+			"Map":                `"map" "[" name "]"`,
+			"Channel":            `"<-" "chan" | "chan" "<-"?`,
+			"Pointer":            `"*"`,
+			"Function":           `"func" "(" (Argument AdditionalArgument*)? ")" Result?`,
+			"Suffix":             `"." name`,
+			"Arguments":          `"[" Argument AdditionalArgument* "]"`,
+			"Argument":           `Abstraction`,
+			"AdditionalArgument": `"," Argument`,
+			"Enumeration":        `"const" "(" Value AdditionalValue* ")"`,
+			"Value":              `(name | "_") Abstraction Initializer`,
+			"AdditionalValue":    `(name | "_") (Abstraction? Initializer)?`,
+			"Initializer":        `"=" Expression`,
+			"Expression":         `Operand Operation*`,
+			"Operation":          `Operator Operand`,
+			"Operator": `
+  - "<<"
+  - ">>"
+  - "+"
+  - "-"
+  - "*"
+  - "/"
+  - "%"
+  - "&^"
+  - "|"
+  - "&"
+  - "^"
+`,
+			"Operand": `
+  - number
+  - path
+  - rune
+  - Reference
+  - "iota"
+  - Unary
+  - Precedence
+`,
+			"Unary":                `("+" | "-" | "^") Operand`,
+			"Precedence":           `"(" Expression ")"`,
+			"Reference":            `name Suffix? Invocation?`,
+			"Invocation":           `"(" Expression? AdditionalExpression* ")"`,
+			"AdditionalExpression": `"," Expression`,
 			"FunctionalSection":    `"// Functional Definitions" FunctionalDefinition+`,
 			"FunctionalDefinition": `Declaration "func" "(" Parameter* ")" Result`,
-			"Parameter":            `name Abstraction ","`,
+			"Parameter":            `name "..."? Abstraction ","`,
 			"Result": `
   - None
   - Abstraction
+  - Tuple
   - Parameterized
 `,
-			"None":                  `newline`,
-			"Parameterized":         `"(" Parameter+ ")"`,
+			"None":               `newline`,
+			"Tuple":              `"(" Argument AdditionalArgument* ","? ")"`,
+			"Parameterized":      `"(" Output AdditionalOutput* ","? ")"`,
+			"Output":             `name Abstraction`,
+			"AdditionalOutput":   `"," Output`,
+			"ConstantSection":    `"// Constant Definitions" ConstantDefinition+`,
+			"ConstantDefinition": `comment Qualifier name Abstraction? Initializer`,
+			"Qualifier": `
+  - "const"
+  - "var"
+`,
 			"ClassSection":          `"// Class Definitions" ClassDefinition+`,
 			"ClassDefinition":       `Declaration "interface" "{" ClassMethods "}"`,
 			"ClassMethods":          `ConstructorSubsection ConstantSubsection? FunctionSubsection?`,
 			"ConstructorSubsection": `"// Constructor Methods" ConstructorMethod+`,
-			"ConstructorMethod":     `name "(" Parameter* ")" Abstraction`,
+			"ConstructorMethod":     `note? name "(" Parameter* ")" Abstraction`,
 			"ConstantSubsection":    `"// Constant Methods" ConstantMethod+`,
-			"ConstantMethod":        `name "(" ")" Abstraction`,
+			"ConstantMethod":        `note? name "(" ")" Abstraction`,
 			"FunctionSubsection":    `"// Function Methods" FunctionMethod+`,
-			"FunctionMethod":        `name "(" Parameter* ")" Result`,
+			"FunctionMethod":        `note? name "(" Parameter* ")" Result`,
 			"InstanceSection":       `"// Instance Definitions" InstanceDefinition+`,
 			"InstanceDefinition":    `Declaration "interface" "{" InstanceMethods "}"`,
 			"InstanceMethods":       `PublicSubsection AttributeSubsection? AspectSubsection?`,
 			"PublicSubsection":      `"// Public Methods" PublicMethod+`,
 			"PublicMethod":          `Method`,
-			"Method":                `name "(" Parameter* ")" Result?`,
+			"Method":                `note? name "(" Parameter* ")" Result?`,
 			"AttributeSubsection":   `"// Attribute Methods" AttributeMethod+`,
 			"AttributeMethod": `
   - GetterMethod
   - SetterMethod
 `,
-			"GetterMethod":     `name "(" ")" Abstraction`,
-			"SetterMethod":     `name "(" Parameter ")"`,
+			"GetterMethod":     `note? name "(" ")" Abstraction`,
+			"SetterMethod":     `note? name "(" Parameter ")"`,
 			"AspectSubsection": `"// Aspect Interfaces" AspectInterface+`,
 			"AspectInterface":  `Abstraction`,
 			"AspectSection":    `"// Aspect Definitions" AspectDefinition+`,
-			"AspectDefinition": `Declaration "interface" "{" AspectMethod+ "}"`,
+			"AspectDefinition": `Declaration "interface" "{" (AspectInterface+ AspectMethod* | AspectMethod+) "}"`,
 			"AspectMethod":     `Method`,
 		},
 	),
@@ -52,16 +52,36 @@ func (v *processor_) ProcessNewline(
 ) {
 }
 
+func (v *processor_) ProcessNote(
+	note string,
+) {
+}
+
+func (v *processor_) ProcessNumber(
+	number string,
+) {
+}
+
 func (v *processor_) ProcessPath(
 	path string,
 ) {
 }
 
+func (v *processor_) ProcessRune(
+	rune_ string,
+) {
+}
+
 func (v *processor_) ProcessSpace(
 	space string,
 ) {
 }
 
+func (v *processor_) ProcessTag(
+	tag string,
+) {
+}
+
 func (v *processor_) PreprocessAbstraction(
 	abstraction ast.AbstractionLike,
 ) {
@@ -115,6 +135,63 @@ func (v *processor_) PostprocessAdditionalConstraint(
 ) {
 }
 
+func (v *processor_) PreprocessAdditionalExpression(
+	additionalExpression ast.AdditionalExpressionLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessAdditionalExpressionSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessAdditionalExpression(
+	additionalExpression ast.AdditionalExpressionLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) PreprocessAdditionalOutput(
+	additionalOutput ast.AdditionalOutputLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessAdditionalOutputSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessAdditionalOutput(
+	additionalOutput ast.AdditionalOutputLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) PreprocessAdditionalTerm(
+	additionalTerm ast.AdditionalTermLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessAdditionalTermSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessAdditionalTerm(
+	additionalTerm ast.AdditionalTermLike,
+	index uint,
+	size uint,
+) {
+}
+
 func (v *processor_) PreprocessAdditionalValue(
 	additionalValue ast.AdditionalValueLike,
 	index uint,
@@ -134,6 +211,21 @@ func (v *processor_) PostprocessAdditionalValue(
 ) {
 }
 
+func (v *processor_) PreprocessAlias(
+	alias ast.AliasLike,
+) {
+}
+
+func (v *processor_) ProcessAliasSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessAlias(
+	alias ast.AliasLike,
+) {
+}
+
 func (v *processor_) PreprocessArgument(
 	argument ast.ArgumentLike,
 ) {
@@ -364,6 +456,25 @@ func (v *processor_) PostprocessClassSection(
 ) {
 }
 
+func (v *processor_) PreprocessConstantDefinition(
+	constantDefinition ast.ConstantDefinitionLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessConstantDefinitionSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessConstantDefinition(
+	constantDefinition ast.ConstantDefinitionLike,
+	index uint,
+	size uint,
+) {
+}
+
 func (v *processor_) PreprocessConstantMethod(
 	constantMethod ast.ConstantMethodLike,
 	index uint,
@@ -383,6 +494,21 @@ func (v *processor_) PostprocessConstantMethod(
 ) {
 }
 
+func (v *processor_) PreprocessConstantSection(
+	constantSection ast.ConstantSectionLike,
+) {
+}
+
+func (v *processor_) ProcessConstantSectionSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessConstantSection(
+	constantSection ast.ConstantSectionLike,
+) {
+}
+
 func (v *processor_) PreprocessConstantSubsection(
 	constantSubsection ast.ConstantSubsectionLike,
 ) {
@@ -492,6 +618,55 @@ func (v *processor_) PostprocessEnumeration(
 ) {
 }
 
+func (v *processor_) PreprocessExpression(
+	expression ast.ExpressionLike,
+) {
+}
+
+func (v *processor_) ProcessExpressionSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessExpression(
+	expression ast.ExpressionLike,
+) {
+}
+
+func (v *processor_) PreprocessField(
+	field ast.FieldLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessFieldSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessField(
+	field ast.FieldLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) PreprocessFunction(
+	function ast.FunctionLike,
+) {
+}
+
+func (v *processor_) ProcessFunctionSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessFunction(
+	function ast.FunctionLike,
+) {
+}
+
 func (v *processor_) PreprocessFunctionMethod(
 	functionMethod ast.FunctionMethodLike,
 	index uint,
@@ -605,6 +780,21 @@ func (v *processor_) PostprocessImports(
 ) {
 }
 
+func (v *processor_) PreprocessInitializer(
+	initializer ast.InitializerLike,
+) {
+}
+
+func (v *processor_) ProcessInitializerSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessInitializer(
+	initializer ast.InitializerLike,
+) {
+}
+
 func (v *processor_) PreprocessInstanceDefinition(
 	instanceDefinition ast.InstanceDefinitionLike,
 	index uint,
@@ -669,6 +859,21 @@ func (v *processor_) PostprocessInterfaceDefinitions(
 ) {
 }
 
+func (v *processor_) PreprocessInvocation(
+	invocation ast.InvocationLike,
+) {
+}
+
+func (v *processor_) ProcessInvocationSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessInvocation(
+	invocation ast.InvocationLike,
+) {
+}
+
 func (v *processor_) PreprocessMap(
 	map_ ast.MapLike,
 ) {
@@ -778,6 +983,70 @@ func (v *processor_) PostprocessNotice(
 ) {
 }
 
+func (v *processor_) PreprocessOperand(
+	operand ast.OperandLike,
+) {
+}
+
+func (v *processor_) ProcessOperandSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessOperand(
+	operand ast.OperandLike,
+) {
+}
+
+func (v *processor_) PreprocessOperation(
+	operation ast.OperationLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessOperationSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessOperation(
+	operation ast.OperationLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) PreprocessOperator(
+	operator ast.OperatorLike,
+) {
+}
+
+func (v *processor_) ProcessOperatorSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessOperator(
+	operator ast.OperatorLike,
+) {
+}
+
+func (v *processor_) PreprocessOutput(
+	output ast.OutputLike,
+) {
+}
+
+func (v *processor_) ProcessOutputSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessOutput(
+	output ast.OutputLike,
+) {
+}
+
 func (v *processor_) PreprocessParameter(
 	parameter ast.ParameterLike,
 	index uint,
@@ -812,8 +1081,40 @@ func (v *processor_) PostprocessParameterized(
 ) {
 }
 
+func (v *processor_) PreprocessPointer(
+	pointer ast.PointerLike,
+) {
+}
+
+func (v *processor_) ProcessPointerSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessPointer(
+	pointer ast.PointerLike,
+) {
+}
+
+func (v *processor_) PreprocessPrecedence(
+	precedence ast.PrecedenceLike,
+) {
+}
+
+func (v *processor_) ProcessPrecedenceSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessPrecedence(
+	precedence ast.PrecedenceLike,
+) {
+}
+
 func (v *processor_) PreprocessPrefix(
 	prefix ast.PrefixLike,
+	index uint,
+	size uint,
 ) {
 }
 
@@ -824,6 +1125,8 @@ func (v *processor_) ProcessPrefixSlot(
 
 func (v *processor_) PostprocessPrefix(
 	prefix ast.PrefixLike,
+	index uint,
+	size uint,
 ) {
 }
 
@@ -876,6 +1179,36 @@ func (v *processor_) PostprocessPublicSubsection(
 ) {
 }
 
+func (v *processor_) PreprocessQualifier(
+	qualifier ast.QualifierLike,
+) {
+}
+
+func (v *processor_) ProcessQualifierSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessQualifier(
+	qualifier ast.QualifierLike,
+) {
+}
+
+func (v *processor_) PreprocessReference(
+	reference ast.ReferenceLike,
+) {
+}
+
+func (v *processor_) ProcessReferenceSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessReference(
+	reference ast.ReferenceLike,
+) {
+}
+
 func (v *processor_) PreprocessResult(
 	result ast.ResultLike,
 ) {
@@ -906,6 +1239,36 @@ func (v *processor_) PostprocessSetterMethod(
 ) {
 }
 
+func (v *processor_) PreprocessSize(
+	size ast.SizeLike,
+) {
+}
+
+func (v *processor_) ProcessSizeSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessSize(
+	size ast.SizeLike,
+) {
+}
+
+func (v *processor_) PreprocessStructure(
+	structure ast.StructureLike,
+) {
+}
+
+func (v *processor_) ProcessStructureSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessStructure(
+	structure ast.StructureLike,
+) {
+}
+
 func (v *processor_) PreprocessSuffix(
 	suffix ast.SuffixLike,
 ) {
@@ -921,6 +1284,36 @@ func (v *processor_) PostprocessSuffix(
 ) {
 }
 
+func (v *processor_) PreprocessTerm(
+	term ast.TermLike,
+) {
+}
+
+func (v *processor_) ProcessTermSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessTerm(
+	term ast.TermLike,
+) {
+}
+
+func (v *processor_) PreprocessTuple(
+	tuple ast.TupleLike,
+) {
+}
+
+func (v *processor_) ProcessTupleSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessTuple(
+	tuple ast.TupleLike,
+) {
+}
+
 func (v *processor_) PreprocessTypeDefinition(
 	typeDefinition ast.TypeDefinitionLike,
 	index uint,
@@ -955,6 +1348,36 @@ func (v *processor_) PostprocessTypeSection(
 ) {
 }
 
+func (v *processor_) PreprocessUnary(
+	unary ast.UnaryLike,
+) {
+}
+
+func (v *processor_) ProcessUnarySlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessUnary(
+	unary ast.UnaryLike,
+) {
+}
+
+func (v *processor_) PreprocessUnderlying(
+	underlying ast.UnderlyingLike,
+) {
+}
+
+func (v *processor_) ProcessUnderlyingSlot(
+	slot uint,
+) {
+}
+
+func (v *processor_) PostprocessUnderlying(
+	underlying ast.UnderlyingLike,
+) {
+}
+
 func (v *processor_) PreprocessValue(
 	value ast.ValueLike,
 ) {
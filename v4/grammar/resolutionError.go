@@ -0,0 +1,135 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package grammar
+
+import (
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+	ast "github.com/craterdog/go-model-framework/v4/ast"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ResolutionError() ResolutionErrorClassLike {
+	return resolutionErrorReference()
+}
+
+// Constructor Methods
+
+func (c *resolutionErrorClass_) Make(
+	packagePath string,
+	abstraction ast.AbstractionLike,
+	optionalModulePath string,
+) ResolutionErrorLike {
+	if uti.IsUndefined(packagePath) {
+		panic("The \"packagePath\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(abstraction) {
+		panic("The \"abstraction\" attribute is required by this class.")
+	}
+	var instance = &resolutionError_{
+		// Initialize the instance attributes.
+		packagePath_:        packagePath,
+		abstraction_:        abstraction,
+		optionalModulePath_: optionalModulePath,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *resolutionError_) GetPackagePath() string {
+	return v.packagePath_
+}
+
+func (v *resolutionError_) GetAbstraction() ast.AbstractionLike {
+	return v.abstraction_
+}
+
+func (v *resolutionError_) GetOptionalModulePath() string {
+	return v.optionalModulePath_
+}
+
+// Public Methods
+
+func (v *resolutionError_) GetClass() ResolutionErrorClassLike {
+	return v.getClass()
+}
+
+func (v *resolutionError_) Error() string {
+	// Describe where the reference was found.
+	var message = v.packagePath_
+	var span = v.abstraction_.GetSpan()
+	if uti.IsDefined(span) {
+		message += fmt.Sprintf(
+			", line %v, position %v",
+			span.GetStart().GetLine(),
+			span.GetStart().GetColumn(),
+		)
+	}
+
+	// Describe why the reference could not be resolved.
+	var moduleName = v.abstraction_.GetName()
+	var typeName = v.abstraction_.GetOptionalSuffix().GetName()
+	if uti.IsDefined(v.optionalModulePath_) {
+		message += fmt.Sprintf(
+			": The type %q is not declared by the imported package %q.",
+			moduleName+"."+typeName,
+			v.optionalModulePath_,
+		)
+	} else {
+		message += fmt.Sprintf(
+			": The module %q referenced by the type %q is not imported.",
+			moduleName,
+			moduleName+"."+typeName,
+		)
+	}
+	return message
+}
+
+// Private Methods
+
+func (v *resolutionError_) getClass() *resolutionErrorClass_ {
+	return resolutionErrorReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type resolutionError_ struct {
+	// Declare the instance attributes.
+	packagePath_        string
+	abstraction_        ast.AbstractionLike
+	optionalModulePath_ string
+}
+
+// Class Structure
+
+type resolutionErrorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func resolutionErrorReference() *resolutionErrorClass_ {
+	return resolutionErrorReference_
+}
+
+var resolutionErrorReference_ = &resolutionErrorClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,194 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package grammar
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+	ast "github.com/craterdog/go-model-framework/v4/ast"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Resolver() ResolverClassLike {
+	return resolverReference()
+}
+
+// Constructor Methods
+
+func (c *resolverClass_) Make(
+	workspace WorkspaceLike,
+) ResolverLike {
+	if uti.IsUndefined(workspace) {
+		panic("The \"workspace\" attribute is required by this class.")
+	}
+	var instance = &resolver_{
+		// Initialize the instance attributes.
+		workspace_:    workspace,
+		modules_:      col.Catalog[string, string](),
+		bindings_:     col.Catalog[ast.AbstractionLike, ast.DeclarationLike](),
+		symbolTables_: col.Catalog[string, SymbolTableLike](),
+
+		// Initialize the inherited aspects.
+		Methodical: Processor().Make(),
+	}
+	instance.visitor_ = Visitor().Make(instance)
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *resolver_) GetWorkspace() WorkspaceLike {
+	return v.workspace_
+}
+
+// Methodical Methods
+
+func (v *resolver_) PreprocessAbstraction(
+	abstraction ast.AbstractionLike,
+) {
+	var suffix = abstraction.GetOptionalSuffix()
+	if uti.IsUndefined(suffix) {
+		// Only references to types in other packages are resolved.
+		return
+	}
+	var modulePath = v.modules_.GetValue(abstraction.GetName())
+	if uti.IsUndefined(modulePath) {
+		if v.unnamed_ {
+			// The module may be an unaliased module outside the workspace.
+			return
+		}
+		var error_ = ResolutionError().Make(v.packagePath_, abstraction, "")
+		v.errors_.AppendValue(error_)
+		return
+	}
+	if uti.IsUndefined(v.workspace_.GetModel(modulePath)) {
+		// The imported package is not in the workspace so it cannot be checked.
+		return
+	}
+	var symbol = v.getSymbolTable(modulePath).LookupType(suffix.GetName())
+	if uti.IsUndefined(symbol) {
+		var error_ = ResolutionError().Make(v.packagePath_, abstraction, modulePath)
+		v.errors_.AppendValue(error_)
+		return
+	}
+	v.bindings_.SetValue(abstraction, symbol.GetOptionalDeclaration())
+}
+
+func (v *resolver_) PreprocessModule(
+	module ast.ModuleLike,
+	index uint,
+	size uint,
+) {
+	// NOTE: The types imported by a dot import are not qualified so references
+	// to them cannot be distinguished from local types and are not resolved.
+	var modulePath = sts.Trim(module.GetPath(), "\"")
+	var moduleName = Workspace().ModuleName(module, v.workspace_)
+	switch moduleName {
+	case "":
+		// The module name is unknown so it may qualify any type.
+		v.unnamed_ = true
+	case "_", ".":
+		// The types of this module are never qualified by a module name.
+	default:
+		v.modules_.SetValue(moduleName, modulePath)
+	}
+}
+
+// Public Methods
+
+func (v *resolver_) GetClass() ResolverClassLike {
+	return v.getClass()
+}
+
+func (v *resolver_) GetDeclaration(
+	abstraction ast.AbstractionLike,
+) ast.DeclarationLike {
+	var result_ = v.bindings_.GetValue(abstraction)
+	return result_
+}
+
+func (v *resolver_) ResolveWorkspace() abs.Sequential[ResolutionErrorLike] {
+	var result_ = col.List[ResolutionErrorLike]()
+	v.errors_ = result_
+	v.bindings_.RemoveAll()
+	var models = v.workspace_.GetModels().GetIterator()
+	for models.HasNext() {
+		var association = models.GetNext()
+		v.packagePath_ = association.GetKey()
+		v.modules_.RemoveAll()
+		v.unnamed_ = false
+		v.visitor_.VisitModel(association.GetValue())
+	}
+	v.errors_ = nil
+	return result_
+}
+
+// Private Methods
+
+func (v *resolver_) getClass() *resolverClass_ {
+	return resolverReference()
+}
+
+func (v *resolver_) getSymbolTable(
+	packagePath string,
+) SymbolTableLike {
+	// Index the symbols in the model of the package the first time.
+	var symbolTable = v.symbolTables_.GetValue(packagePath)
+	if uti.IsUndefined(symbolTable) {
+		symbolTable = SymbolTable().Make(v.workspace_.GetModel(packagePath))
+		v.symbolTables_.SetValue(packagePath, symbolTable)
+	}
+	return symbolTable
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type resolver_ struct {
+	// Declare the instance attributes.
+	visitor_      VisitorLike
+	workspace_    WorkspaceLike
+	packagePath_  string                                                    // The package currently being resolved.
+	modules_      abs.CatalogLike[string, string]                           // The imported module paths keyed by name.
+	unnamed_      bool                                                      // Whether a module with an unknown name is imported.
+	bindings_     abs.CatalogLike[ast.AbstractionLike, ast.DeclarationLike] // The declaration bound to each reference.
+	symbolTables_ abs.CatalogLike[string, SymbolTableLike]                  // The symbols declared by each package.
+	errors_       abs.ListLike[ResolutionErrorLike]
+
+	// Declare the inherited aspects.
+	Methodical
+}
+
+// Class Structure
+
+type resolverClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func resolverReference() *resolverClass_ {
+	return resolverReference_
+}
+
+var resolverReference_ = &resolverClass_{
+	// Initialize the class constants.
+}
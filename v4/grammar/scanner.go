@@ -14,11 +14,13 @@ package grammar
 
 import (
 	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v4"
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	uti "github.com/craterdog/go-missing-utilities/v2"
 	reg "regexp"
 	sts "strings"
 	uni "unicode"
+	utf "unicode/utf8"
 )
 
 // CLASS INTERFACE
@@ -45,18 +47,56 @@ func (c *scannerClass_) Make(
 		// Initialize the instance attributes.
 		line_:     1,
 		position_: 1,
-		runes_:    []rune(source),
+		source_:   source,
 		tokens_:   tokens,
 	}
 	go instance.scanTokens() // Do scanning in the background...
 	return instance
 }
 
+func (c *scannerClass_) MakeWithSource(
+	source string,
+) ScannerLike {
+	if uti.IsUndefined(source) {
+		panic("The \"source\" attribute is required by this class.")
+	}
+	var instance = &scanner_{
+		// Initialize the instance attributes.
+		line_:     1,
+		position_: 1,
+		source_:   source,
+	}
+	return instance
+}
+
+// Constant Methods
+
+func (c *scannerClass_) TabSize() uint {
+	return c.tabSize_
+}
+
 // Function Methods
 
 func (c *scannerClass_) FormatToken(token TokenLike) string {
 	var result_ string
 	var value = token.GetValue()
+	switch value {
+	// Show a lone control character by name.
+	case "\x00":
+		value = "<NULL>"
+	case "\a":
+		value = "<BELL>"
+	case "\b":
+		value = "<BKSP>"
+	case "\t":
+		value = "<HTAB>"
+	case "\f":
+		value = "<FMFD>"
+	case "\r":
+		value = "<CRTN>"
+	case "\v":
+		value = "<VTAB>"
+	}
 	value = fmt.Sprintf("%q", value)
 	if len(value) > 40 {
 		value = fmt.Sprintf("%.40q...", value)
@@ -87,6 +127,21 @@ func (c *scannerClass_) MatchesType(
 	return result_
 }
 
+func (c *scannerClass_) ScanTokens(
+	source string,
+) abs.Sequential[TokenLike] {
+	var result_ = col.List[TokenLike]()
+	if uti.IsDefined(source) {
+		var scanner = c.MakeWithSource(source)
+		var token = scanner.ScanToken()
+		for token != nil {
+			result_.AppendValue(token)
+			token = scanner.ScanToken()
+		}
+	}
+	return result_
+}
+
 // INSTANCE INTERFACE
 
 // Public Methods
@@ -95,43 +150,40 @@ func (v *scanner_) GetClass() ScannerClassLike {
 	return v.getClass()
 }
 
+func (v *scanner_) ScanToken() TokenLike {
+	// Scan the next token on the goroutine of the caller.
+	var result_ TokenLike
+	if v.tokens_ != nil {
+		panic("The tokens for this scanner are being scanned in the background.")
+	}
+	result_ = v.scanToken()
+	return result_
+}
+
 // Private Methods
 
 func (v *scanner_) getClass() *scannerClass_ {
 	return scannerReference()
 }
 
-func (v *scanner_) emitToken(tokenType TokenType) {
-	var value = string(v.runes_[v.first_:v.next_])
-	switch value {
-	case "\x00":
-		value = "<NULL>"
-	case "\a":
-		value = "<BELL>"
-	case "\b":
-		value = "<BKSP>"
-	case "\t":
-		value = "<HTAB>"
-	case "\f":
-		value = "<FMFD>"
-	case "\r":
-		value = "<CRTN>"
-	case "\v":
-		value = "<VTAB>"
-	}
-	var token = Token().Make(v.line_, v.position_, tokenType, value)
+func (v *scanner_) emitToken(tokenType TokenType, value string) {
+	var token = Token().Make(v.line_, v.position_, v.offset_, tokenType, value)
 	//fmt.Println(Scanner().FormatToken(token)) // Uncomment when debugging.
-	v.tokens_.AddValue(token) // This will block if the queue is full.
+	v.token_ = token
 }
 
 func (v *scanner_) foundError() {
-	v.next_++
-	v.emitToken(ErrorToken)
+	var text = v.source_[v.offset_:]
+	var _, size = utf.DecodeRuneInString(text)
+	v.emitToken(ErrorToken, text[:size])
+	v.failed_ = true // No more tokens will be scanned.
 }
 
 func (v *scanner_) foundToken(tokenType TokenType) bool {
-	// Attempt to match the specified token type.
-	var text = string(v.runes_[v.next_:])
+	// Attempt to match the specified token type against the rest of the source.
+	// NOTE: Slicing the source string does not copy it so each attempt takes
+	// time proportional to the length of the match rather than the source.
+	var text = v.source_[v.offset_:]
 	var matcher = v.getClass().matchers_[tokenType]
 	var match = matcher.FindString(text)
 	if len(match) == 0 {
@@ -139,56 +191,78 @@ func (v *scanner_) foundToken(tokenType TokenType) bool {
 	}
 
 	// Check for false delimiter matches.
-	var token = []rune(match)
-	var length = uint(len(token))
-	var previous = token[length-1]
-	if tokenType == DelimiterToken && uint(len(v.runes_)) > v.next_+length {
-		var next = v.runes_[v.next_+length]
+	if tokenType == DelimiterToken && len(text) > len(match) {
+		var previous, _ = utf.DecodeLastRuneInString(match)
+		var next, _ = utf.DecodeRuneInString(text[len(match):])
 		if (uni.IsLetter(previous) || uni.IsNumber(previous)) &&
 			(uni.IsLetter(next) || uni.IsNumber(next) || next == '_') {
 			return false
 		}
+
+		// A division operator may not begin a note.
+		if match == "/" && next == '/' {
+			return false
+		}
+
+		// A reserved comment must make up the rest of its line.
+		if sts.HasPrefix(match, "//") && next != '\r' && next != '\n' {
+			return false
+		}
 	}
 
 	// Found the requested token type.
-	v.next_ += length
-	v.emitToken(tokenType)
-	var count = uint(sts.Count(match, "\n"))
-	if count > 0 {
-		v.line_ += count
-		v.position_ = v.indexOfLastEol(token)
-	} else {
-		v.position_ += v.next_ - v.first_
-	}
-	v.first_ = v.next_
+	v.emitToken(tokenType, match)
+	v.advancePosition(match)
+	v.offset_ += uint(len(match))
 	return true
 }
 
-func (v *scanner_) indexOfLastEol(runes []rune) uint {
-	var length = uint(len(runes))
-	for index := length; index > 0; index-- {
-		if runes[index-1] == '\n' {
-			return length - index + 1
+func (v *scanner_) advancePosition(text string) {
+	// Track the visual column of the next rune, expanding any tabs.
+	var tabSize = v.getClass().tabSize_
+	for _, character := range text {
+		switch character {
+		case '\n':
+			v.line_++
+			v.position_ = 1
+		case '\t':
+			v.position_ = ((v.position_-1)/tabSize+1)*tabSize + 1
+		default:
+			v.position_++
 		}
 	}
-	return 0
+}
+
+func (v *scanner_) scanToken() TokenLike {
+	// Check for the end of the source.
+	v.token_ = nil
+	if v.failed_ || v.offset_ >= uint(len(v.source_)) {
+		return v.token_
+	}
+
+	// Find the next token type.
+	switch {
+	case v.foundToken(CommentToken):
+	case v.foundToken(DelimiterToken):
+	case v.foundToken(NameToken):
+	case v.foundToken(NewlineToken):
+	case v.foundToken(NoteToken):
+	case v.foundToken(NumberToken):
+	case v.foundToken(PathToken):
+	case v.foundToken(RuneToken):
+	case v.foundToken(SpaceToken):
+	case v.foundToken(TagToken):
+	default:
+		v.foundError()
+	}
+	return v.token_
 }
 
 func (v *scanner_) scanTokens() {
-loop:
-	for v.next_ < uint(len(v.runes_)) {
-		switch {
-		// Find the next token type.
-		case v.foundToken(CommentToken):
-		case v.foundToken(DelimiterToken):
-		case v.foundToken(NameToken):
-		case v.foundToken(NewlineToken):
-		case v.foundToken(PathToken):
-		case v.foundToken(SpaceToken):
-		default:
-			v.foundError()
-			break loop
-		}
+	var token = v.scanToken()
+	for token != nil {
+		v.tokens_.AddValue(token) // This will block if the queue is full.
+		token = v.scanToken()
 	}
 	v.tokens_.CloseQueue()
 }
@@ -199,11 +273,12 @@ loop:
 
 type scanner_ struct {
 	// Declare the instance attributes.
-	first_    uint // A zero based index of the first possible rune in the next token.
-	next_     uint // A zero based index of the next possible rune in the next token.
 	line_     uint // The line number in the source string of the next rune.
-	position_ uint // The position in the current line of the next rune.
-	runes_    []rune
+	position_ uint // The visual column in the current line of the next rune.
+	offset_   uint // A zero based byte offset in the source string of the next rune.
+	failed_   bool // Whether or not an unrecognized character has been found.
+	source_   string
+	token_    TokenLike // The most recently scanned token, if any.
 	tokens_   abs.QueueLike[TokenLike]
 }
 
@@ -211,6 +286,7 @@ type scanner_ struct {
 
 type scannerClass_ struct {
 	// Declare the class constants.
+	tabSize_  uint
 	tokens_   map[TokenType]string
 	matchers_ map[TokenType]*reg.Regexp
 }
@@ -223,22 +299,31 @@ func scannerReference() *scannerClass_ {
 
 var scannerReference_ = &scannerClass_{
 	// Initialize the class constants.
+	tabSize_: 4,
 	tokens_: map[TokenType]string{
 		ErrorToken:     "error",
 		CommentToken:   "comment",
 		DelimiterToken: "delimiter",
 		NameToken:      "name",
 		NewlineToken:   "newline",
+		NoteToken:      "note",
+		NumberToken:    "number",
 		PathToken:      "path",
+		RuneToken:      "rune",
 		SpaceToken:     "space",
+		TagToken:       "tag",
 	},
 	matchers_: map[TokenType]*reg.Regexp{
 		CommentToken:   reg.MustCompile("^" + comment_),
 		DelimiterToken: reg.MustCompile("^" + delimiter_),
 		NameToken:      reg.MustCompile("^" + name_),
 		NewlineToken:   reg.MustCompile("^" + newline_),
+		NoteToken:      reg.MustCompile("^" + note_),
+		NumberToken:    reg.MustCompile("^" + number_),
 		PathToken:      reg.MustCompile("^" + path_),
+		RuneToken:      reg.MustCompile("^" + rune_),
 		SpaceToken:     reg.MustCompile("^" + space_),
+		TagToken:       reg.MustCompile("^" + tag_),
 	},
 }
 
@@ -261,11 +346,18 @@ const (
 	lower_   = "\\p{Ll}"
 	upper_   = "\\p{Lu}"
 
+	// Define the regular expression patterns shared by several token types.
+	decimal_ = "(?:" + digit_ + "+(_" + digit_ + "+)*)"
+
 	// Define the regular expression patterns for each token type.
 	comment_   = "(?:/\\*" + eol_ + "(" + any_ + "|" + eol_ + ")*?" + eol_ + "\\*/" + eol_ + ")"
-	delimiter_ = "(?:type|package|map|iota|interface|import|func|const|chan|\\}|\\{|\\]|\\[|\\.|\\)|\\(|=|// Type Definitions|// Public Methods|// Instance Definitions|// Functional Definitions|// Function Methods|// Constructor Methods|// Constant Methods|// Class Definitions|// Attribute Methods|// Aspect Definitions|// Aspect Methods|,)"
+	delimiter_ = "(?:var|type|struct|package|map|iota|interface|import|func|const|chan|\\}|\\{|\\]|\\[|\\.\\.\\.|\\.|\\)|\\(|=|<<|<-|>>|\\+|-|\\*|%|&\\^|\\||&|\\^|~|_|// Type Definitions|// Public Methods|// Instance Definitions|// Functional Definitions|// Function Methods|// Constructor Methods|// Constant Definitions|// Constant Methods|// Class Definitions|// Attribute Methods|// Aspect Definitions|// Aspect Methods|/|,)"
 	name_      = "(?:(" + lower_ + "|" + upper_ + ")(" + lower_ + "|" + upper_ + "|" + digit_ + ")*_?)"
 	newline_   = "(?:\\r?\\n)"
-	path_      = "(?:\"" + any_ + "*?\")"
+	note_      = "(?://" + any_ + "*(" + eol_ + "[ \\t]*//" + any_ + "*)*)"
+	number_    = "(?:0[xX]_?[0-9a-fA-F]+(_[0-9a-fA-F]+)*|0[bB]_?[01]+(_[01]+)*|0[oO]_?[0-7]+(_[0-7]+)*|" + decimal_ + "(\\." + decimal_ + ")?([eE][+-]?" + decimal_ + ")?)"
+	path_      = "(?:\"(\\\\" + any_ + "|[^\"\\\\\\r\\n])*\")"
+	rune_      = "(?:'(\\\\" + any_ + "[0-9a-fA-F]*|[^'\\\\\\r\\n])')"
 	space_     = "(?:[ \\t]+)"
+	tag_       = "(?:`[^`\\r\\n]*`)"
 )
@@ -0,0 +1,113 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package grammar
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+	ast "github.com/craterdog/go-model-framework/v4/ast"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Symbol() SymbolClassLike {
+	return symbolReference()
+}
+
+// Constructor Methods
+
+func (c *symbolClass_) Make(
+	name string,
+	kind SymbolKind,
+	optionalDeclaration ast.DeclarationLike,
+	definition ast.Spanned,
+) SymbolLike {
+	if uti.IsUndefined(name) {
+		panic("The \"name\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(kind) {
+		panic("The \"kind\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(definition) {
+		panic("The \"definition\" attribute is required by this class.")
+	}
+	var instance = &symbol_{
+		// Initialize the instance attributes.
+		name_:                name,
+		kind_:                kind,
+		optionalDeclaration_: optionalDeclaration,
+		definition_:          definition,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *symbol_) GetName() string {
+	return v.name_
+}
+
+func (v *symbol_) GetKind() SymbolKind {
+	return v.kind_
+}
+
+func (v *symbol_) GetOptionalDeclaration() ast.DeclarationLike {
+	return v.optionalDeclaration_
+}
+
+func (v *symbol_) GetDefinition() ast.Spanned {
+	return v.definition_
+}
+
+// Public Methods
+
+func (v *symbol_) GetClass() SymbolClassLike {
+	return v.getClass()
+}
+
+// Private Methods
+
+func (v *symbol_) getClass() *symbolClass_ {
+	return symbolReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type symbol_ struct {
+	// Declare the instance attributes.
+	name_                string
+	kind_                SymbolKind
+	optionalDeclaration_ ast.DeclarationLike
+	definition_          ast.Spanned
+}
+
+// Class Structure
+
+type symbolClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func symbolReference() *symbolClass_ {
+	return symbolReference_
+}
+
+var symbolReference_ = &symbolClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,305 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package grammar
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+	ast "github.com/craterdog/go-model-framework/v4/ast"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func SymbolTable() SymbolTableClassLike {
+	return symbolTableReference()
+}
+
+// Constructor Methods
+
+func (c *symbolTableClass_) Make(
+	model ast.ModelLike,
+) SymbolTableLike {
+	if uti.IsUndefined(model) {
+		panic("The \"model\" attribute is required by this class.")
+	}
+	var instance = &symbolTable_{
+		// Initialize the instance attributes.
+		model_:   model,
+		symbols_: col.List[SymbolLike](),
+		names_:   col.Catalog[string, SymbolLike](),
+
+		// Initialize the inherited aspects.
+		Methodical: Processor().Make(),
+	}
+	instance.visitor_ = Visitor().Make(instance)
+	instance.visitor_.VisitModel(model)
+	return instance
+}
+
+// Function Methods
+
+func (c *symbolTableClass_) IsPredeclared(
+	name string,
+) bool {
+	var result_ = c.predeclaredTypes_.ContainsValue(name)
+	return result_
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *symbolTable_) GetModel() ast.ModelLike {
+	return v.model_
+}
+
+// Methodical Methods
+
+func (v *symbolTable_) PreprocessAdditionalValue(
+	additionalValue ast.AdditionalValueLike,
+	index uint,
+	size uint,
+) {
+	var name = additionalValue.GetName()
+	if name != "_" {
+		// A blank value declares nothing.
+		v.addSymbol(name, ValueSymbol, additionalValue)
+	}
+}
+
+func (v *symbolTable_) PreprocessAspectDefinition(
+	aspectDefinition ast.AspectDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = aspectDefinition.GetDeclaration()
+	v.addSymbol(v.declaration_.GetName(), AspectSymbol, aspectDefinition)
+}
+
+func (v *symbolTable_) PreprocessClassDefinition(
+	classDefinition ast.ClassDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = classDefinition.GetDeclaration()
+	v.addSymbol(v.declaration_.GetName(), ClassSymbol, classDefinition)
+}
+
+func (v *symbolTable_) PreprocessConstantDefinition(
+	constantDefinition ast.ConstantDefinitionLike,
+	index uint,
+	size uint,
+) {
+	// A constant definition has no declaration.
+	v.declaration_ = nil
+	v.addSymbol(constantDefinition.GetName(), ConstantSymbol, constantDefinition)
+}
+
+func (v *symbolTable_) PreprocessConstraint(
+	constraint ast.ConstraintLike,
+) {
+	v.addSymbol(constraint.GetName(), ParameterSymbol, constraint)
+}
+
+func (v *symbolTable_) PreprocessFunctionalDefinition(
+	functionalDefinition ast.FunctionalDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = functionalDefinition.GetDeclaration()
+	v.addSymbol(v.declaration_.GetName(), FunctionalSymbol, functionalDefinition)
+}
+
+func (v *symbolTable_) PreprocessInstanceDefinition(
+	instanceDefinition ast.InstanceDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = instanceDefinition.GetDeclaration()
+	v.addSymbol(v.declaration_.GetName(), InstanceSymbol, instanceDefinition)
+}
+
+func (v *symbolTable_) PreprocessTypeDefinition(
+	typeDefinition ast.TypeDefinitionLike,
+	index uint,
+	size uint,
+) {
+	v.declaration_ = typeDefinition.GetDeclaration()
+	v.addSymbol(v.declaration_.GetName(), TypeSymbol, typeDefinition)
+}
+
+func (v *symbolTable_) PreprocessValue(
+	value ast.ValueLike,
+) {
+	var name = value.GetName()
+	if name != "_" {
+		// A blank value declares nothing.
+		v.addSymbol(name, ValueSymbol, value)
+	}
+}
+
+// Public Methods
+
+func (v *symbolTable_) GetClass() SymbolTableClassLike {
+	return v.getClass()
+}
+
+func (v *symbolTable_) GetKind(
+	name string,
+) SymbolKind {
+	var result_ = UnknownSymbol
+	var symbol = v.names_.GetValue(name)
+	if uti.IsDefined(symbol) {
+		result_ = symbol.GetKind()
+	}
+	return result_
+}
+
+func (v *symbolTable_) GetSymbols(
+	kind SymbolKind,
+) abs.Sequential[SymbolLike] {
+	var result_ = col.List[SymbolLike]()
+	var symbols = v.symbols_.GetIterator()
+	for symbols.HasNext() {
+		var symbol = symbols.GetNext()
+		if symbol.GetKind() == kind {
+			result_.AppendValue(symbol)
+		}
+	}
+	return result_
+}
+
+func (v *symbolTable_) IsDeclared(
+	name string,
+	optionalDeclaration ast.DeclarationLike,
+) bool {
+	var result_ = v.getClass().IsPredeclared(name) ||
+		uti.IsDefined(v.LookupType(name)) ||
+		uti.IsDefined(v.LookupParameter(optionalDeclaration, name))
+	return result_
+}
+
+func (v *symbolTable_) LookupParameter(
+	declaration ast.DeclarationLike,
+	name string,
+) SymbolLike {
+	var result_ SymbolLike
+	var symbols = v.symbols_.GetIterator()
+	for symbols.HasNext() {
+		var symbol = symbols.GetNext()
+		if symbol.GetKind() == ParameterSymbol &&
+			symbol.GetOptionalDeclaration() == declaration &&
+			symbol.GetName() == name {
+			result_ = symbol
+			break
+		}
+	}
+	return result_
+}
+
+func (v *symbolTable_) LookupSymbol(
+	name string,
+) SymbolLike {
+	var result_ = v.names_.GetValue(name)
+	return result_
+}
+
+func (v *symbolTable_) LookupSymbols(
+	name string,
+) abs.Sequential[SymbolLike] {
+	var result_ = col.List[SymbolLike]()
+	var symbols = v.symbols_.GetIterator()
+	for symbols.HasNext() {
+		var symbol = symbols.GetNext()
+		if symbol.GetName() == name {
+			result_.AppendValue(symbol)
+		}
+	}
+	return result_
+}
+
+func (v *symbolTable_) LookupType(
+	name string,
+) SymbolLike {
+	var result_ SymbolLike
+	var symbol = v.names_.GetValue(name)
+	if uti.IsDefined(symbol) {
+		switch symbol.GetKind() {
+		case AspectSymbol, ClassSymbol, FunctionalSymbol, InstanceSymbol, TypeSymbol:
+			result_ = symbol
+		}
+	}
+	return result_
+}
+
+// Private Methods
+
+func (v *symbolTable_) getClass() *symbolTableClass_ {
+	return symbolTableReference()
+}
+
+func (v *symbolTable_) addSymbol(
+	name string,
+	kind SymbolKind,
+	definition ast.Spanned,
+) {
+	var symbol = Symbol().Make(name, kind, v.declaration_, definition)
+	v.symbols_.AppendValue(symbol)
+
+	// Generic parameters are scoped to their declaration so only the other
+	// symbols are indexed by name.  The first declaration of a name wins.
+	if kind != ParameterSymbol && uti.IsUndefined(v.names_.GetValue(name)) {
+		v.names_.SetValue(name, symbol)
+	}
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type symbolTable_ struct {
+	// Declare the instance attributes.
+	visitor_     VisitorLike
+	model_       ast.ModelLike
+	declaration_ ast.DeclarationLike // The declaration currently being indexed.
+	symbols_     abs.ListLike[SymbolLike]
+	names_       abs.CatalogLike[string, SymbolLike]
+
+	// Declare the inherited aspects.
+	Methodical
+}
+
+// Class Structure
+
+type symbolTableClass_ struct {
+	// Declare the class constants.
+	predeclaredTypes_ abs.SetLike[string]
+}
+
+// Class Reference
+
+func symbolTableReference() *symbolTableClass_ {
+	return symbolTableReference_
+}
+
+var symbolTableReference_ = &symbolTableClass_{
+	// Initialize the class constants.
+	predeclaredTypes_: col.Set[string]([]string{
+		"any", "bool", "byte", "comparable", "complex64", "complex128", "error",
+		"float32", "float64", "int", "int8", "int16", "int32", "int64", "rune",
+		"string", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+	}),
+}
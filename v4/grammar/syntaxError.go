@@ -0,0 +1,145 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package grammar
+
+import (
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func SyntaxError() SyntaxErrorClassLike {
+	return syntaxErrorReference()
+}
+
+// Constructor Methods
+
+func (c *syntaxErrorClass_) Make(
+	line uint,
+	position uint,
+	optionalToken TokenLike,
+	optionalRuleName string,
+	optionalDefinition string,
+) SyntaxErrorLike {
+	if uti.IsUndefined(line) {
+		panic("The \"line\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(position) {
+		panic("The \"position\" attribute is required by this class.")
+	}
+	var instance = &syntaxError_{
+		// Initialize the instance attributes.
+		line_:               line,
+		position_:           position,
+		optionalToken_:      optionalToken,
+		optionalRuleName_:   optionalRuleName,
+		optionalDefinition_: optionalDefinition,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *syntaxError_) GetLine() uint {
+	return v.line_
+}
+
+func (v *syntaxError_) GetPosition() uint {
+	return v.position_
+}
+
+func (v *syntaxError_) GetOptionalToken() TokenLike {
+	return v.optionalToken_
+}
+
+func (v *syntaxError_) GetOptionalRuleName() string {
+	return v.optionalRuleName_
+}
+
+func (v *syntaxError_) GetOptionalDefinition() string {
+	return v.optionalDefinition_
+}
+
+// Public Methods
+
+func (v *syntaxError_) GetClass() SyntaxErrorClassLike {
+	return v.getClass()
+}
+
+func (v *syntaxError_) Error() string {
+	// Describe what was found.
+	var message = fmt.Sprintf(
+		"Line %v, position %v: ",
+		v.line_,
+		v.position_,
+	)
+	if uti.IsDefined(v.optionalToken_) {
+		message += fmt.Sprintf(
+			"An unexpected %v token was found: %q",
+			Scanner().FormatType(v.optionalToken_.GetType()),
+			v.optionalToken_.GetValue(),
+		)
+	} else {
+		message += "The end of the source was reached unexpectedly."
+	}
+
+	// Describe what was expected.
+	if uti.IsDefined(v.optionalRuleName_) {
+		message += fmt.Sprintf(
+			"\nWas expecting:\n  %v: %v",
+			v.optionalRuleName_,
+			v.optionalDefinition_,
+		)
+	}
+	return message
+}
+
+// Private Methods
+
+func (v *syntaxError_) getClass() *syntaxErrorClass_ {
+	return syntaxErrorReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type syntaxError_ struct {
+	// Declare the instance attributes.
+	line_               uint
+	position_           uint
+	optionalToken_      TokenLike
+	optionalRuleName_   string
+	optionalDefinition_ string
+}
+
+// Class Structure
+
+type syntaxErrorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func syntaxErrorReference() *syntaxErrorClass_ {
+	return syntaxErrorReference_
+}
+
+var syntaxErrorReference_ = &syntaxErrorClass_{
+	// Initialize the class constants.
+}
@@ -29,6 +29,7 @@ func Token() TokenClassLike {
 func (c *tokenClass_) Make(
 	line uint,
 	position uint,
+	offset uint,
 	type_ TokenType,
 	value string,
 ) TokenLike {
@@ -38,6 +39,9 @@ func (c *tokenClass_) Make(
 	if uti.IsUndefined(position) {
 		panic("The \"position\" attribute is required by this class.")
 	}
+	if uti.IsUndefined(offset) {
+		panic("The \"offset\" attribute is required by this class.")
+	}
 	if uti.IsUndefined(type_) {
 		panic("The \"type\" attribute is required by this class.")
 	}
@@ -48,6 +52,7 @@ func (c *tokenClass_) Make(
 		// Initialize the instance attributes.
 		line_:     line,
 		position_: position,
+		offset_:   offset,
 		type_:     type_,
 		value_:    value,
 	}
@@ -66,6 +71,10 @@ func (v *token_) GetPosition() uint {
 	return v.position_
 }
 
+func (v *token_) GetOffset() uint {
+	return v.offset_
+}
+
 func (v *token_) GetType() TokenType {
 	return v.type_
 }
@@ -94,6 +103,7 @@ type token_ struct {
 	// Declare the instance attributes.
 	line_     uint
 	position_ uint
+	offset_   uint
 	type_     TokenType
 	value_    string
 }
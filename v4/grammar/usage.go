@@ -0,0 +1,104 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package grammar
+
+import (
+	uti "github.com/craterdog/go-missing-utilities/v2"
+	ast "github.com/craterdog/go-model-framework/v4/ast"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Usage() UsageClassLike {
+	return usageReference()
+}
+
+// Constructor Methods
+
+func (c *usageClass_) Make(
+	abstraction ast.AbstractionLike,
+	optionalDeclaration ast.DeclarationLike,
+	optionalMethod string,
+) UsageLike {
+	if uti.IsUndefined(abstraction) {
+		panic("The \"abstraction\" attribute is required by this class.")
+	}
+	var instance = &usage_{
+		// Initialize the instance attributes.
+		abstraction_:         abstraction,
+		optionalDeclaration_: optionalDeclaration,
+		optionalMethod_:      optionalMethod,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *usage_) GetAbstraction() ast.AbstractionLike {
+	return v.abstraction_
+}
+
+func (v *usage_) GetOptionalDeclaration() ast.DeclarationLike {
+	return v.optionalDeclaration_
+}
+
+func (v *usage_) GetOptionalMethod() string {
+	return v.optionalMethod_
+}
+
+// Public Methods
+
+func (v *usage_) GetClass() UsageClassLike {
+	return v.getClass()
+}
+
+func (v *usage_) GetSpan() ast.SpanLike {
+	return v.abstraction_.GetSpan()
+}
+
+// Private Methods
+
+func (v *usage_) getClass() *usageClass_ {
+	return usageReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type usage_ struct {
+	// Declare the instance attributes.
+	abstraction_         ast.AbstractionLike
+	optionalDeclaration_ ast.DeclarationLike
+	optionalMethod_      string
+}
+
+// Class Structure
+
+type usageClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func usageReference() *usageClass_ {
+	return usageReference_
+}
+
+var usageReference_ = &usageClass_{
+	// Initialize the class constants.
+}
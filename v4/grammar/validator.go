@@ -14,8 +14,12 @@ package grammar
 
 import (
 	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v4"
+	uti "github.com/craterdog/go-missing-utilities/v2"
 	ast "github.com/craterdog/go-model-framework/v4/ast"
+	slc "slices"
 	sts "strings"
+	uni "unicode"
 )
 
 // CLASS INTERFACE
@@ -55,14 +59,73 @@ func (v *validator_) ProcessNewline(newline string) {
 	v.validateToken(newline, NewlineToken)
 }
 
+func (v *validator_) ProcessNote(note string) {
+	v.validateToken(note, NoteToken)
+}
+
+func (v *validator_) ProcessNumber(number string) {
+	v.validateToken(number, NumberToken)
+}
+
 func (v *validator_) ProcessPath(path string) {
 	v.validateToken(path, PathToken)
 }
 
+func (v *validator_) ProcessRune(rune_ string) {
+	v.validateToken(rune_, RuneToken)
+}
+
 func (v *validator_) ProcessSpace(space string) {
 	v.validateToken(space, SpaceToken)
 }
 
+func (v *validator_) ProcessTag(tag string) {
+	v.validateToken(tag, TagToken)
+}
+
+func (v *validator_) PreprocessAbstraction(
+	abstraction ast.AbstractionLike,
+) {
+	var name = abstraction.GetName()
+	if len(name) == 0 || uti.IsDefined(abstraction.GetOptionalSuffix()) {
+		// Only unqualified type names are declared within the model.
+		return
+	}
+	v.validateDeclared(name)
+}
+
+func (v *validator_) PreprocessAspectDefinition(
+	aspectDefinition ast.AspectDefinitionLike,
+	index uint,
+	size uint,
+) {
+	var aspectName = aspectDefinition.GetDeclaration().GetName()
+	v.validateEmbeddings([]string{aspectName}, aspectDefinition)
+}
+
+func (v *validator_) PreprocessConstantDefinition(
+	constantDefinition ast.ConstantDefinitionLike,
+	index uint,
+	size uint,
+) {
+	// A constant definition is not within the scope of any generic parameters.
+	v.declaration_ = nil
+	var name = constantDefinition.GetName()
+	if !uni.IsUpper([]rune(name)[0]) {
+		var message = fmt.Sprintf(
+			"A constant definition must declare an exported name: %v",
+			name,
+		)
+		panic(message)
+	}
+}
+
+func (v *validator_) PreprocessDeclaration(
+	declaration ast.DeclarationLike,
+) {
+	v.declaration_ = declaration
+}
+
 func (v *validator_) PreprocessInterfaceDefinitions(
 	interfaceDefinition ast.InterfaceDefinitionsLike,
 ) {
@@ -89,6 +152,85 @@ func (v *validator_) PreprocessInterfaceDefinitions(
 	}
 }
 
+func (v *validator_) PreprocessMap(
+	map_ ast.MapLike,
+) {
+	v.validateDeclared(map_.GetName())
+}
+
+func (v *validator_) PreprocessModule(
+	module ast.ModuleLike,
+	index uint,
+	size uint,
+) {
+	// The types provided by a dot import cannot be checked.
+	var alias = module.GetOptionalAlias()
+	if uti.IsDefined(alias) && alias.GetAny() == "." {
+		v.dotImports_ = true
+	}
+}
+
+func (v *validator_) PreprocessParameter(
+	parameter ast.ParameterLike,
+	index uint,
+	size uint,
+) {
+	if parameter.IsVariadic() && index < size {
+		var message = fmt.Sprintf(
+			"Only the last parameter may be variadic: %v",
+			parameter.GetName(),
+		)
+		panic(message)
+	}
+}
+
+func (v *validator_) PreprocessSetterMethod(
+	setterMethod ast.SetterMethodLike,
+) {
+	var parameter = setterMethod.GetParameter()
+	if parameter.IsVariadic() {
+		var message = fmt.Sprintf(
+			"A setter method parameter may not be variadic: %v",
+			setterMethod.GetName(),
+		)
+		panic(message)
+	}
+}
+
+func (v *validator_) PreprocessStructure(
+	structure ast.StructureLike,
+) {
+	var names = col.Set[string]()
+	var fields = structure.GetFields().GetIterator()
+	for fields.HasNext() {
+		var name = fields.GetNext().GetName()
+		if names.ContainsValue(name) {
+			var message = fmt.Sprintf(
+				"A structure may not define the same field more than once: %v",
+				name,
+			)
+			panic(message)
+		}
+		names.AddValue(name)
+	}
+}
+
+func (v *validator_) PreprocessTypeDefinition(
+	typeDefinition ast.TypeDefinitionLike,
+	index uint,
+	size uint,
+) {
+	var underlying = typeDefinition.GetUnderlying().GetAny()
+	var _, isStructure = underlying.(ast.StructureLike)
+	if isStructure && uti.IsDefined(typeDefinition.GetOptionalEnumeration()) {
+		var message = fmt.Sprintf(
+			"A structure type may not define an enumeration: %v",
+			typeDefinition.GetDeclaration().GetName(),
+		)
+		panic(message)
+	}
+}
+
 // Public Methods
 
 func (v *validator_) GetClass() ValidatorClassLike {
@@ -98,6 +240,9 @@ func (v *validator_) GetClass() ValidatorClassLike {
 func (v *validator_) ValidateModel(
 	model ast.ModelLike,
 ) {
+	v.symbols_ = SymbolTable().Make(model)
+	v.declaration_ = nil
+	v.dotImports_ = false
 	v.visitor_.VisitModel(model)
 }
 
@@ -107,6 +252,50 @@ func (v *validator_) getClass() *validatorClass_ {
 	return validatorReference()
 }
 
+func (v *validator_) validateDeclared(name string) {
+	if v.dotImports_ || v.symbols_.IsDeclared(name, v.declaration_) {
+		return
+	}
+	var message = fmt.Sprintf(
+		"The following type is not declared by the model: %v",
+		name,
+	)
+	panic(message)
+}
+
+func (v *validator_) validateEmbeddings(
+	path []string,
+	aspectDefinition ast.AspectDefinitionLike,
+) {
+	// Follow the embedded aspects that are defined by this model, an imported
+	// aspect cannot embed an aspect from this model.
+	var aspectInterfaces = aspectDefinition.GetAspectInterfaces().GetIterator()
+	for aspectInterfaces.HasNext() {
+		var abstraction = aspectInterfaces.GetNext().GetAbstraction()
+		if uti.IsDefined(abstraction.GetOptionalSuffix()) {
+			continue
+		}
+		var name = abstraction.GetName()
+		var embeddings = append(slc.Clone(path), name)
+		if name == path[0] {
+			var message = fmt.Sprintf(
+				"An aspect may not embed itself: %v",
+				sts.Join(embeddings, " -> "),
+			)
+			panic(message)
+		}
+		if slc.Contains(path, name) {
+			// This cycle is reported when its first aspect is validated.
+			continue
+		}
+		var symbol = v.symbols_.LookupSymbol(name)
+		if uti.IsDefined(symbol) && symbol.GetKind() == AspectSymbol {
+			var embedded = symbol.GetDefinition().(ast.AspectDefinitionLike)
+			v.validateEmbeddings(embeddings, embedded)
+		}
+	}
+}
+
 func (v *validator_) validateToken(
 	tokenValue string,
 	tokenType TokenType,
@@ -127,7 +316,10 @@ func (v *validator_) validateToken(
 
 type validator_ struct {
 	// Declare the instance attributes.
-	visitor_ VisitorLike
+	visitor_     VisitorLike
+	symbols_     SymbolTableLike
+	declaration_ ast.DeclarationLike // The declaration currently in scope.
+	dotImports_  bool
 
 	// Define the inherited aspects.
 	Methodical
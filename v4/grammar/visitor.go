@@ -64,24 +64,49 @@ func (v *visitor_) getClass() *visitorClass_ {
 }
 
 func (v *visitor_) visitAbstraction(abstraction ast.AbstractionLike) {
-	// Visit the optional prefix rule.
-	var optionalPrefix = abstraction.GetOptionalPrefix()
-	if uti.IsDefined(optionalPrefix) {
-		v.processor_.PreprocessPrefix(optionalPrefix)
-		v.visitPrefix(optionalPrefix)
-		v.processor_.PostprocessPrefix(optionalPrefix)
+	// Visit each prefix rule.
+	var prefixIndex uint
+	var prefixes = abstraction.GetPrefixes().GetIterator()
+	var prefixesSize = uint(prefixes.GetSize())
+	for prefixes.HasNext() {
+		prefixIndex++
+		var prefix = prefixes.GetNext()
+		v.processor_.PreprocessPrefix(
+			prefix,
+			prefixIndex,
+			prefixesSize,
+		)
+		v.visitPrefix(prefix)
+		v.processor_.PostprocessPrefix(
+			prefix,
+			prefixIndex,
+			prefixesSize,
+		)
 	}
 
 	// Visit slot 1 between references.
 	v.processor_.ProcessAbstractionSlot(1)
 
-	// Visit the name token.
-	var name = abstraction.GetName()
-	v.processor_.ProcessName(name)
+	// Visit the optional function rule.
+	var optionalFunction = abstraction.GetOptionalFunction()
+	if uti.IsDefined(optionalFunction) {
+		v.processor_.PreprocessFunction(optionalFunction)
+		v.visitFunction(optionalFunction)
+		v.processor_.PostprocessFunction(optionalFunction)
+	}
 
 	// Visit slot 2 between references.
 	v.processor_.ProcessAbstractionSlot(2)
 
+	// Visit the optional name token.
+	var name = abstraction.GetName()
+	if uti.IsDefined(name) {
+		v.processor_.ProcessName(name)
+	}
+
+	// Visit slot 3 between references.
+	v.processor_.ProcessAbstractionSlot(3)
+
 	// Visit the optional suffix rule.
 	var optionalSuffix = abstraction.GetOptionalSuffix()
 	if uti.IsDefined(optionalSuffix) {
@@ -90,8 +115,8 @@ func (v *visitor_) visitAbstraction(abstraction ast.AbstractionLike) {
 		v.processor_.PostprocessSuffix(optionalSuffix)
 	}
 
-	// Visit slot 3 between references.
-	v.processor_.ProcessAbstractionSlot(3)
+	// Visit slot 4 between references.
+	v.processor_.ProcessAbstractionSlot(4)
 
 	// Visit the optional arguments rule.
 	var optionalArguments = abstraction.GetOptionalArguments()
@@ -118,10 +143,75 @@ func (v *visitor_) visitAdditionalConstraint(additionalConstraint ast.Additional
 	v.processor_.PostprocessConstraint(constraint)
 }
 
+func (v *visitor_) visitAdditionalExpression(additionalExpression ast.AdditionalExpressionLike) {
+	// Visit the expression rule.
+	var expression = additionalExpression.GetExpression()
+	v.processor_.PreprocessExpression(expression)
+	v.visitExpression(expression)
+	v.processor_.PostprocessExpression(expression)
+}
+
+func (v *visitor_) visitAdditionalOutput(additionalOutput ast.AdditionalOutputLike) {
+	// Visit the output rule.
+	var output = additionalOutput.GetOutput()
+	v.processor_.PreprocessOutput(output)
+	v.visitOutput(output)
+	v.processor_.PostprocessOutput(output)
+}
+
+func (v *visitor_) visitAdditionalTerm(additionalTerm ast.AdditionalTermLike) {
+	// Visit the term rule.
+	var term = additionalTerm.GetTerm()
+	v.processor_.PreprocessTerm(term)
+	v.visitTerm(term)
+	v.processor_.PostprocessTerm(term)
+}
+
 func (v *visitor_) visitAdditionalValue(additionalValue ast.AdditionalValueLike) {
-	// Visit the name token.
+	// Visit the name token or "_" delimiter.
 	var name = additionalValue.GetName()
-	v.processor_.ProcessName(name)
+	if name != "_" {
+		v.processor_.ProcessName(name)
+	}
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessAdditionalValueSlot(1)
+
+	// Visit the optional abstraction rule.
+	var optionalAbstraction = additionalValue.GetOptionalAbstraction()
+	if uti.IsDefined(optionalAbstraction) {
+		v.processor_.PreprocessAbstraction(optionalAbstraction)
+		v.visitAbstraction(optionalAbstraction)
+		v.processor_.PostprocessAbstraction(optionalAbstraction)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessAdditionalValueSlot(2)
+
+	// Visit the optional initializer rule.
+	var optionalInitializer = additionalValue.GetOptionalInitializer()
+	if uti.IsDefined(optionalInitializer) {
+		v.processor_.PreprocessInitializer(optionalInitializer)
+		v.visitInitializer(optionalInitializer)
+		v.processor_.PostprocessInitializer(optionalInitializer)
+	}
+}
+
+func (v *visitor_) visitAlias(alias ast.AliasLike) {
+	// Visit the possible alias types.
+	switch actual := alias.GetAny().(type) {
+	case string:
+		switch {
+		case actual == "." || actual == "_":
+			// This is a delimiter rather than a name.
+		case Scanner().MatchesType(actual, NameToken):
+			v.processor_.ProcessName(actual)
+		default:
+			panic(fmt.Sprintf("Invalid token: %v", actual))
+		}
+	default:
+		panic(fmt.Sprintf("Invalid rule type: %T", actual))
+	}
 }
 
 func (v *visitor_) visitArgument(argument ast.ArgumentLike) {
@@ -163,7 +253,15 @@ func (v *visitor_) visitArguments(arguments ast.ArgumentsLike) {
 	}
 }
 
-func (v *visitor_) visitArray(array ast.ArrayLike) {}
+func (v *visitor_) visitArray(array ast.ArrayLike) {
+	// Visit the optional size rule.
+	var optionalSize = array.GetOptionalSize()
+	if uti.IsDefined(optionalSize) {
+		v.processor_.PreprocessSize(optionalSize)
+		v.visitSize(optionalSize)
+		v.processor_.PostprocessSize(optionalSize)
+	}
+}
 
 func (v *visitor_) visitAspectDefinition(aspectDefinition ast.AspectDefinitionLike) {
 	// Visit the declaration rule.
@@ -175,6 +273,29 @@ func (v *visitor_) visitAspectDefinition(aspectDefinition ast.AspectDefinitionLi
 	// Visit slot 1 between references.
 	v.processor_.ProcessAspectDefinitionSlot(1)
 
+	// Visit each aspectInterface rule.
+	var aspectInterfaceIndex uint
+	var aspectInterfaces = aspectDefinition.GetAspectInterfaces().GetIterator()
+	var aspectInterfacesSize = uint(aspectInterfaces.GetSize())
+	for aspectInterfaces.HasNext() {
+		aspectInterfaceIndex++
+		var aspectInterface = aspectInterfaces.GetNext()
+		v.processor_.PreprocessAspectInterface(
+			aspectInterface,
+			aspectInterfaceIndex,
+			aspectInterfacesSize,
+		)
+		v.visitAspectInterface(aspectInterface)
+		v.processor_.PostprocessAspectInterface(
+			aspectInterface,
+			aspectInterfaceIndex,
+			aspectInterfacesSize,
+		)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessAspectDefinitionSlot(2)
+
 	// Visit each aspectMethod rule.
 	var aspectMethodIndex uint
 	var aspectMethods = aspectDefinition.GetAspectMethods().GetIterator()
@@ -366,14 +487,65 @@ func (v *visitor_) visitClassSection(classSection ast.ClassSectionLike) {
 	}
 }
 
-func (v *visitor_) visitConstantMethod(constantMethod ast.ConstantMethodLike) {
+func (v *visitor_) visitConstantDefinition(constantDefinition ast.ConstantDefinitionLike) {
+	// Visit the comment token.
+	var comment = constantDefinition.GetComment()
+	v.processor_.ProcessComment(comment)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessConstantDefinitionSlot(1)
+
+	// Visit the qualifier rule.
+	var qualifier = constantDefinition.GetQualifier()
+	v.processor_.PreprocessQualifier(qualifier)
+	v.visitQualifier(qualifier)
+	v.processor_.PostprocessQualifier(qualifier)
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessConstantDefinitionSlot(2)
+
 	// Visit the name token.
-	var name = constantMethod.GetName()
+	var name = constantDefinition.GetName()
 	v.processor_.ProcessName(name)
 
+	// Visit slot 3 between references.
+	v.processor_.ProcessConstantDefinitionSlot(3)
+
+	// Visit the optional abstraction rule.
+	var optionalAbstraction = constantDefinition.GetOptionalAbstraction()
+	if uti.IsDefined(optionalAbstraction) {
+		v.processor_.PreprocessAbstraction(optionalAbstraction)
+		v.visitAbstraction(optionalAbstraction)
+		v.processor_.PostprocessAbstraction(optionalAbstraction)
+	}
+
+	// Visit slot 4 between references.
+	v.processor_.ProcessConstantDefinitionSlot(4)
+
+	// Visit the initializer rule.
+	var initializer = constantDefinition.GetInitializer()
+	v.processor_.PreprocessInitializer(initializer)
+	v.visitInitializer(initializer)
+	v.processor_.PostprocessInitializer(initializer)
+}
+
+func (v *visitor_) visitConstantMethod(constantMethod ast.ConstantMethodLike) {
+	// Visit the optional note token.
+	var optionalNote = constantMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
 	// Visit slot 1 between references.
 	v.processor_.ProcessConstantMethodSlot(1)
 
+	// Visit the name token.
+	var name = constantMethod.GetName()
+	v.processor_.ProcessName(name)
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessConstantMethodSlot(2)
+
 	// Visit the abstraction rule.
 	var abstraction = constantMethod.GetAbstraction()
 	v.processor_.PreprocessAbstraction(abstraction)
@@ -381,6 +553,28 @@ func (v *visitor_) visitConstantMethod(constantMethod ast.ConstantMethodLike) {
 	v.processor_.PostprocessAbstraction(abstraction)
 }
 
+func (v *visitor_) visitConstantSection(constantSection ast.ConstantSectionLike) {
+	// Visit each constantDefinition rule.
+	var constantDefinitionIndex uint
+	var constantDefinitions = constantSection.GetConstantDefinitions().GetIterator()
+	var constantDefinitionsSize = uint(constantDefinitions.GetSize())
+	for constantDefinitions.HasNext() {
+		constantDefinitionIndex++
+		var constantDefinition = constantDefinitions.GetNext()
+		v.processor_.PreprocessConstantDefinition(
+			constantDefinition,
+			constantDefinitionIndex,
+			constantDefinitionsSize,
+		)
+		v.visitConstantDefinition(constantDefinition)
+		v.processor_.PostprocessConstantDefinition(
+			constantDefinition,
+			constantDefinitionIndex,
+			constantDefinitionsSize,
+		)
+	}
+}
+
 func (v *visitor_) visitConstantSubsection(constantSubsection ast.ConstantSubsectionLike) {
 	// Visit each constantMethod rule.
 	var constantMethodIndex uint
@@ -411,11 +605,34 @@ func (v *visitor_) visitConstraint(constraint ast.ConstraintLike) {
 	// Visit slot 1 between references.
 	v.processor_.ProcessConstraintSlot(1)
 
-	// Visit the abstraction rule.
-	var abstraction = constraint.GetAbstraction()
-	v.processor_.PreprocessAbstraction(abstraction)
-	v.visitAbstraction(abstraction)
-	v.processor_.PostprocessAbstraction(abstraction)
+	// Visit the term rule.
+	var term = constraint.GetTerm()
+	v.processor_.PreprocessTerm(term)
+	v.visitTerm(term)
+	v.processor_.PostprocessTerm(term)
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessConstraintSlot(2)
+
+	// Visit each additionalTerm rule.
+	var additionalTermIndex uint
+	var additionalTerms = constraint.GetAdditionalTerms().GetIterator()
+	var additionalTermsSize = uint(additionalTerms.GetSize())
+	for additionalTerms.HasNext() {
+		additionalTermIndex++
+		var additionalTerm = additionalTerms.GetNext()
+		v.processor_.PreprocessAdditionalTerm(
+			additionalTerm,
+			additionalTermIndex,
+			additionalTermsSize,
+		)
+		v.visitAdditionalTerm(additionalTerm)
+		v.processor_.PostprocessAdditionalTerm(
+			additionalTerm,
+			additionalTermIndex,
+			additionalTermsSize,
+		)
+	}
 }
 
 func (v *visitor_) visitConstraints(constraints ast.ConstraintsLike) {
@@ -450,12 +667,21 @@ func (v *visitor_) visitConstraints(constraints ast.ConstraintsLike) {
 }
 
 func (v *visitor_) visitConstructorMethod(constructorMethod ast.ConstructorMethodLike) {
+	// Visit the optional note token.
+	var optionalNote = constructorMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessConstructorMethodSlot(1)
+
 	// Visit the name token.
 	var name = constructorMethod.GetName()
 	v.processor_.ProcessName(name)
 
-	// Visit slot 1 between references.
-	v.processor_.ProcessConstructorMethodSlot(1)
+	// Visit slot 2 between references.
+	v.processor_.ProcessConstructorMethodSlot(2)
 
 	// Visit each parameter rule.
 	var parameterIndex uint
@@ -477,8 +703,8 @@ func (v *visitor_) visitConstructorMethod(constructorMethod ast.ConstructorMetho
 		)
 	}
 
-	// Visit slot 2 between references.
-	v.processor_.ProcessConstructorMethodSlot(2)
+	// Visit slot 3 between references.
+	v.processor_.ProcessConstructorMethodSlot(3)
 
 	// Visit the abstraction rule.
 	var abstraction = constructorMethod.GetAbstraction()
@@ -564,14 +790,122 @@ func (v *visitor_) visitEnumeration(enumeration ast.EnumerationLike) {
 	}
 }
 
-func (v *visitor_) visitFunctionMethod(functionMethod ast.FunctionMethodLike) {
+func (v *visitor_) visitExpression(expression ast.ExpressionLike) {
+	// Visit the operand rule.
+	var operand = expression.GetOperand()
+	v.processor_.PreprocessOperand(operand)
+	v.visitOperand(operand)
+	v.processor_.PostprocessOperand(operand)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessExpressionSlot(1)
+
+	// Visit each operation rule.
+	var operationIndex uint
+	var operations = expression.GetOperations().GetIterator()
+	var operationsSize = uint(operations.GetSize())
+	for operations.HasNext() {
+		operationIndex++
+		var operation = operations.GetNext()
+		v.processor_.PreprocessOperation(
+			operation,
+			operationIndex,
+			operationsSize,
+		)
+		v.visitOperation(operation)
+		v.processor_.PostprocessOperation(
+			operation,
+			operationIndex,
+			operationsSize,
+		)
+	}
+}
+
+func (v *visitor_) visitField(field ast.FieldLike) {
 	// Visit the name token.
-	var name = functionMethod.GetName()
+	var name = field.GetName()
 	v.processor_.ProcessName(name)
 
+	// Visit slot 1 between references.
+	v.processor_.ProcessFieldSlot(1)
+
+	// Visit the abstraction rule.
+	var abstraction = field.GetAbstraction()
+	v.processor_.PreprocessAbstraction(abstraction)
+	v.visitAbstraction(abstraction)
+	v.processor_.PostprocessAbstraction(abstraction)
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessFieldSlot(2)
+
+	// Visit the optional tag token.
+	var optionalTag = field.GetOptionalTag()
+	if uti.IsDefined(optionalTag) {
+		v.processor_.ProcessTag(optionalTag)
+	}
+}
+
+func (v *visitor_) visitFunction(function ast.FunctionLike) {
+	// Visit the optional argument rule.
+	var optionalArgument = function.GetOptionalArgument()
+	if uti.IsDefined(optionalArgument) {
+		v.processor_.PreprocessArgument(optionalArgument)
+		v.visitArgument(optionalArgument)
+		v.processor_.PostprocessArgument(optionalArgument)
+	}
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessFunctionSlot(1)
+
+	// Visit each additionalArgument rule.
+	var additionalArgumentIndex uint
+	var additionalArguments = function.GetAdditionalArguments().GetIterator()
+	var additionalArgumentsSize = uint(additionalArguments.GetSize())
+	for additionalArguments.HasNext() {
+		additionalArgumentIndex++
+		var additionalArgument = additionalArguments.GetNext()
+		v.processor_.PreprocessAdditionalArgument(
+			additionalArgument,
+			additionalArgumentIndex,
+			additionalArgumentsSize,
+		)
+		v.visitAdditionalArgument(additionalArgument)
+		v.processor_.PostprocessAdditionalArgument(
+			additionalArgument,
+			additionalArgumentIndex,
+			additionalArgumentsSize,
+		)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessFunctionSlot(2)
+
+	// Visit the optional result rule.
+	var optionalResult = function.GetOptionalResult()
+	if uti.IsDefined(optionalResult) {
+		v.processor_.PreprocessResult(optionalResult)
+		v.visitResult(optionalResult)
+		v.processor_.PostprocessResult(optionalResult)
+	}
+}
+
+func (v *visitor_) visitFunctionMethod(functionMethod ast.FunctionMethodLike) {
+	// Visit the optional note token.
+	var optionalNote = functionMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
 	// Visit slot 1 between references.
 	v.processor_.ProcessFunctionMethodSlot(1)
 
+	// Visit the name token.
+	var name = functionMethod.GetName()
+	v.processor_.ProcessName(name)
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessFunctionMethodSlot(2)
+
 	// Visit each parameter rule.
 	var parameterIndex uint
 	var parameters = functionMethod.GetParameters().GetIterator()
@@ -592,8 +926,8 @@ func (v *visitor_) visitFunctionMethod(functionMethod ast.FunctionMethodLike) {
 		)
 	}
 
-	// Visit slot 2 between references.
-	v.processor_.ProcessFunctionMethodSlot(2)
+	// Visit slot 3 between references.
+	v.processor_.ProcessFunctionMethodSlot(3)
 
 	// Visit the result rule.
 	var result = functionMethod.GetResult()
@@ -687,12 +1021,21 @@ func (v *visitor_) visitFunctionalSection(functionalSection ast.FunctionalSectio
 }
 
 func (v *visitor_) visitGetterMethod(getterMethod ast.GetterMethodLike) {
+	// Visit the optional note token.
+	var optionalNote = getterMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessGetterMethodSlot(1)
+
 	// Visit the name token.
 	var name = getterMethod.GetName()
 	v.processor_.ProcessName(name)
 
-	// Visit slot 1 between references.
-	v.processor_.ProcessGetterMethodSlot(1)
+	// Visit slot 2 between references.
+	v.processor_.ProcessGetterMethodSlot(2)
 
 	// Visit the single abstraction rule.
 	var abstraction = getterMethod.GetAbstraction()
@@ -738,6 +1081,14 @@ func (v *visitor_) visitImports(imports ast.ImportsLike) {
 	}
 }
 
+func (v *visitor_) visitInitializer(initializer ast.InitializerLike) {
+	// Visit the expression rule.
+	var expression = initializer.GetExpression()
+	v.processor_.PreprocessExpression(expression)
+	v.visitExpression(expression)
+	v.processor_.PostprocessExpression(expression)
+}
+
 func (v *visitor_) visitInstanceDefinition(instanceDefinition ast.InstanceDefinitionLike) {
 	// Visit the declaration rule.
 	var declaration = instanceDefinition.GetDeclaration()
@@ -835,6 +1186,39 @@ func (v *visitor_) visitInterfaceDefinitions(interfaceDefinitions ast.InterfaceD
 	}
 }
 
+func (v *visitor_) visitInvocation(invocation ast.InvocationLike) {
+	// Visit the optional expression rule.
+	var optionalExpression = invocation.GetOptionalExpression()
+	if uti.IsDefined(optionalExpression) {
+		v.processor_.PreprocessExpression(optionalExpression)
+		v.visitExpression(optionalExpression)
+		v.processor_.PostprocessExpression(optionalExpression)
+	}
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessInvocationSlot(1)
+
+	// Visit each additionalExpression rule.
+	var additionalExpressionIndex uint
+	var additionalExpressions = invocation.GetAdditionalExpressions().GetIterator()
+	var additionalExpressionsSize = uint(additionalExpressions.GetSize())
+	for additionalExpressions.HasNext() {
+		additionalExpressionIndex++
+		var additionalExpression = additionalExpressions.GetNext()
+		v.processor_.PreprocessAdditionalExpression(
+			additionalExpression,
+			additionalExpressionIndex,
+			additionalExpressionsSize,
+		)
+		v.visitAdditionalExpression(additionalExpression)
+		v.processor_.PostprocessAdditionalExpression(
+			additionalExpression,
+			additionalExpressionIndex,
+			additionalExpressionsSize,
+		)
+	}
+}
+
 func (v *visitor_) visitMap(map_ ast.MapLike) {
 	// Visit the name token.
 	var name = map_.GetName()
@@ -842,12 +1226,21 @@ func (v *visitor_) visitMap(map_ ast.MapLike) {
 }
 
 func (v *visitor_) visitMethod(method ast.MethodLike) {
+	// Visit the optional note token.
+	var optionalNote = method.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessMethodSlot(1)
+
 	// Visit the name token.
 	var name = method.GetName()
 	v.processor_.ProcessName(name)
 
-	// Visit slot 1 between references.
-	v.processor_.ProcessMethodSlot(1)
+	// Visit slot 2 between references.
+	v.processor_.ProcessMethodSlot(2)
 
 	// Visit each parameter rule.
 	var parameterIndex uint
@@ -869,8 +1262,8 @@ func (v *visitor_) visitMethod(method ast.MethodLike) {
 		)
 	}
 
-	// Visit slot 2 between references.
-	v.processor_.ProcessMethodSlot(2)
+	// Visit slot 3 between references.
+	v.processor_.ProcessMethodSlot(3)
 
 	// Visit the optional result rule.
 	var optionalResult = method.GetOptionalResult()
@@ -908,9 +1301,13 @@ func (v *visitor_) visitModel(model ast.ModelLike) {
 }
 
 func (v *visitor_) visitModule(module ast.ModuleLike) {
-	// Visit the name token.
-	var name = module.GetName()
-	v.processor_.ProcessName(name)
+	// Visit the optional alias rule.
+	var optionalAlias = module.GetOptionalAlias()
+	if uti.IsDefined(optionalAlias) {
+		v.processor_.PreprocessAlias(optionalAlias)
+		v.visitAlias(optionalAlias)
+		v.processor_.PostprocessAlias(optionalAlias)
+	}
 
 	// Visit slot 1 between references.
 	v.processor_.ProcessModuleSlot(1)
@@ -960,6 +1357,86 @@ func (v *visitor_) visitNotice(notice ast.NoticeLike) {
 	v.processor_.ProcessComment(comment)
 }
 
+func (v *visitor_) visitOperand(operand ast.OperandLike) {
+	// Visit the possible operand types.
+	switch actual := operand.GetAny().(type) {
+	case ast.ReferenceLike:
+		v.processor_.PreprocessReference(actual)
+		v.visitReference(actual)
+		v.processor_.PostprocessReference(actual)
+	case ast.UnaryLike:
+		v.processor_.PreprocessUnary(actual)
+		v.visitUnary(actual)
+		v.processor_.PostprocessUnary(actual)
+	case ast.PrecedenceLike:
+		v.processor_.PreprocessPrecedence(actual)
+		v.visitPrecedence(actual)
+		v.processor_.PostprocessPrecedence(actual)
+	case string:
+		switch {
+		case Scanner().MatchesType(actual, NumberToken):
+			v.processor_.ProcessNumber(actual)
+		case Scanner().MatchesType(actual, PathToken):
+			v.processor_.ProcessPath(actual)
+		case Scanner().MatchesType(actual, RuneToken):
+			v.processor_.ProcessRune(actual)
+		case actual == "iota":
+			// This is a delimiter.
+		default:
+			panic(fmt.Sprintf("Invalid token: %v", actual))
+		}
+	default:
+		panic(fmt.Sprintf("Invalid rule type: %T", actual))
+	}
+}
+
+func (v *visitor_) visitOperation(operation ast.OperationLike) {
+	// Visit the operator rule.
+	var operator = operation.GetOperator()
+	v.processor_.PreprocessOperator(operator)
+	v.visitOperator(operator)
+	v.processor_.PostprocessOperator(operator)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessOperationSlot(1)
+
+	// Visit the operand rule.
+	var operand = operation.GetOperand()
+	v.processor_.PreprocessOperand(operand)
+	v.visitOperand(operand)
+	v.processor_.PostprocessOperand(operand)
+}
+
+func (v *visitor_) visitOperator(operator ast.OperatorLike) {
+	// Visit the possible operator types.
+	switch actual := operator.GetAny().(type) {
+	case string:
+		switch actual {
+		case "<<", ">>", "+", "-", "*", "/", "%", "&^", "|", "&", "^":
+			// The operator is a delimiter.
+		default:
+			panic(fmt.Sprintf("Invalid token: %v", actual))
+		}
+	default:
+		panic(fmt.Sprintf("Invalid rule type: %T", actual))
+	}
+}
+
+func (v *visitor_) visitOutput(output ast.OutputLike) {
+	// Visit the name token.
+	var name = output.GetName()
+	v.processor_.ProcessName(name)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessOutputSlot(1)
+
+	// Visit the abstraction rule.
+	var abstraction = output.GetAbstraction()
+	v.processor_.PreprocessAbstraction(abstraction)
+	v.visitAbstraction(abstraction)
+	v.processor_.PostprocessAbstraction(abstraction)
+}
+
 func (v *visitor_) visitParameter(parameter ast.ParameterLike) {
 	// Visit the name token.
 	var name = parameter.GetName()
@@ -976,27 +1453,47 @@ func (v *visitor_) visitParameter(parameter ast.ParameterLike) {
 }
 
 func (v *visitor_) visitParameterized(parameterized ast.ParameterizedLike) {
-	// Visit each parameter rule.
-	var parameterIndex uint
-	var parameters = parameterized.GetParameters().GetIterator()
-	var parametersSize = uint(parameters.GetSize())
-	for parameters.HasNext() {
-		parameterIndex++
-		var parameter = parameters.GetNext()
-		v.processor_.PreprocessParameter(
-			parameter,
-			parameterIndex,
-			parametersSize,
+	// Visit the output rule.
+	var output = parameterized.GetOutput()
+	v.processor_.PreprocessOutput(output)
+	v.visitOutput(output)
+	v.processor_.PostprocessOutput(output)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessParameterizedSlot(1)
+
+	// Visit each additionalOutput rule.
+	var additionalOutputIndex uint
+	var additionalOutputs = parameterized.GetAdditionalOutputs().GetIterator()
+	var additionalOutputsSize = uint(additionalOutputs.GetSize())
+	for additionalOutputs.HasNext() {
+		additionalOutputIndex++
+		var additionalOutput = additionalOutputs.GetNext()
+		v.processor_.PreprocessAdditionalOutput(
+			additionalOutput,
+			additionalOutputIndex,
+			additionalOutputsSize,
 		)
-		v.visitParameter(parameter)
-		v.processor_.PostprocessParameter(
-			parameter,
-			parameterIndex,
-			parametersSize,
+		v.visitAdditionalOutput(additionalOutput)
+		v.processor_.PostprocessAdditionalOutput(
+			additionalOutput,
+			additionalOutputIndex,
+			additionalOutputsSize,
 		)
 	}
 }
 
+func (v *visitor_) visitPointer(pointer ast.PointerLike) {
+}
+
+func (v *visitor_) visitPrecedence(precedence ast.PrecedenceLike) {
+	// Visit the expression rule.
+	var expression = precedence.GetExpression()
+	v.processor_.PreprocessExpression(expression)
+	v.visitExpression(expression)
+	v.processor_.PostprocessExpression(expression)
+}
+
 func (v *visitor_) visitPrefix(prefix ast.PrefixLike) {
 	// Visit the possible prefix types.
 	switch actual := prefix.GetAny().(type) {
@@ -1012,6 +1509,10 @@ func (v *visitor_) visitPrefix(prefix ast.PrefixLike) {
 		v.processor_.PreprocessChannel(actual)
 		v.visitChannel(actual)
 		v.processor_.PostprocessChannel(actual)
+	case ast.PointerLike:
+		v.processor_.PreprocessPointer(actual)
+		v.visitPointer(actual)
+		v.processor_.PostprocessPointer(actual)
 	case string:
 		switch {
 		default:
@@ -1041,6 +1542,17 @@ func (v *visitor_) visitPrimitiveDefinitions(primitiveDefinitions ast.PrimitiveD
 		v.visitFunctionalSection(optionalFunctionalSection)
 		v.processor_.PostprocessFunctionalSection(optionalFunctionalSection)
 	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessPrimitiveDefinitionsSlot(2)
+
+	// Visit the optional constantSection rule.
+	var optionalConstantSection = primitiveDefinitions.GetOptionalConstantSection()
+	if uti.IsDefined(optionalConstantSection) {
+		v.processor_.PreprocessConstantSection(optionalConstantSection)
+		v.visitConstantSection(optionalConstantSection)
+		v.processor_.PostprocessConstantSection(optionalConstantSection)
+	}
 }
 
 func (v *visitor_) visitPublicMethod(publicMethod ast.PublicMethodLike) {
@@ -1073,6 +1585,49 @@ func (v *visitor_) visitPublicSubsection(publicSubsection ast.PublicSubsectionLi
 	}
 }
 
+func (v *visitor_) visitQualifier(qualifier ast.QualifierLike) {
+	// Visit the possible qualifier types.
+	switch actual := qualifier.GetAny().(type) {
+	case string:
+		switch actual {
+		case "const", "var":
+			// The qualifier is a delimiter.
+		default:
+			panic(fmt.Sprintf("Invalid token: %v", actual))
+		}
+	default:
+		panic(fmt.Sprintf("Invalid rule type: %T", actual))
+	}
+}
+
+func (v *visitor_) visitReference(reference ast.ReferenceLike) {
+	// Visit the name token.
+	var name = reference.GetName()
+	v.processor_.ProcessName(name)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessReferenceSlot(1)
+
+	// Visit the optional suffix rule.
+	var optionalSuffix = reference.GetOptionalSuffix()
+	if uti.IsDefined(optionalSuffix) {
+		v.processor_.PreprocessSuffix(optionalSuffix)
+		v.visitSuffix(optionalSuffix)
+		v.processor_.PostprocessSuffix(optionalSuffix)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessReferenceSlot(2)
+
+	// Visit the optional invocation rule.
+	var optionalInvocation = reference.GetOptionalInvocation()
+	if uti.IsDefined(optionalInvocation) {
+		v.processor_.PreprocessInvocation(optionalInvocation)
+		v.visitInvocation(optionalInvocation)
+		v.processor_.PostprocessInvocation(optionalInvocation)
+	}
+}
+
 func (v *visitor_) visitResult(result ast.ResultLike) {
 	// Visit the possible result types.
 	switch actual := result.GetAny().(type) {
@@ -1084,6 +1639,10 @@ func (v *visitor_) visitResult(result ast.ResultLike) {
 		v.processor_.PreprocessAbstraction(actual)
 		v.visitAbstraction(actual)
 		v.processor_.PostprocessAbstraction(actual)
+	case ast.TupleLike:
+		v.processor_.PreprocessTuple(actual)
+		v.visitTuple(actual)
+		v.processor_.PostprocessTuple(actual)
 	case ast.ParameterizedLike:
 		v.processor_.PreprocessParameterized(actual)
 		v.visitParameterized(actual)
@@ -1099,12 +1658,21 @@ func (v *visitor_) visitResult(result ast.ResultLike) {
 }
 
 func (v *visitor_) visitSetterMethod(setterMethod ast.SetterMethodLike) {
+	// Visit the optional note token.
+	var optionalNote = setterMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessSetterMethodSlot(1)
+
 	// Visit the name token.
 	var name = setterMethod.GetName()
 	v.processor_.ProcessName(name)
 
-	// Visit slot 1 between references.
-	v.processor_.ProcessSetterMethodSlot(1)
+	// Visit slot 2 between references.
+	v.processor_.ProcessSetterMethodSlot(2)
 
 	// Visit the parameter rule.
 	var parameter = setterMethod.GetParameter()
@@ -1115,12 +1683,90 @@ func (v *visitor_) visitSetterMethod(setterMethod ast.SetterMethodLike) {
 	}
 }
 
+func (v *visitor_) visitSize(size ast.SizeLike) {
+	// Visit the possible size types.
+	switch actual := size.GetAny().(type) {
+	case string:
+		switch {
+		case Scanner().MatchesType(actual, NumberToken):
+			v.processor_.ProcessNumber(actual)
+		case Scanner().MatchesType(actual, NameToken):
+			v.processor_.ProcessName(actual)
+		default:
+			panic(fmt.Sprintf("Invalid token: %v", actual))
+		}
+	default:
+		panic(fmt.Sprintf("Invalid rule type: %T", actual))
+	}
+}
+
+func (v *visitor_) visitStructure(structure ast.StructureLike) {
+	// Visit each field rule.
+	var fieldIndex uint
+	var fields = structure.GetFields().GetIterator()
+	var fieldsSize = uint(fields.GetSize())
+	for fields.HasNext() {
+		fieldIndex++
+		var field = fields.GetNext()
+		v.processor_.PreprocessField(
+			field,
+			fieldIndex,
+			fieldsSize,
+		)
+		v.visitField(field)
+		v.processor_.PostprocessField(
+			field,
+			fieldIndex,
+			fieldsSize,
+		)
+	}
+}
+
 func (v *visitor_) visitSuffix(suffix ast.SuffixLike) {
 	// Visit the name token.
 	var name = suffix.GetName()
 	v.processor_.ProcessName(name)
 }
 
+func (v *visitor_) visitTerm(term ast.TermLike) {
+	// Visit the abstraction rule.
+	var abstraction = term.GetAbstraction()
+	v.processor_.PreprocessAbstraction(abstraction)
+	v.visitAbstraction(abstraction)
+	v.processor_.PostprocessAbstraction(abstraction)
+}
+
+func (v *visitor_) visitTuple(tuple ast.TupleLike) {
+	// Visit the argument rule.
+	var argument = tuple.GetArgument()
+	v.processor_.PreprocessArgument(argument)
+	v.visitArgument(argument)
+	v.processor_.PostprocessArgument(argument)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessTupleSlot(1)
+
+	// Visit each additionalArgument rule.
+	var additionalArgumentIndex uint
+	var additionalArguments = tuple.GetAdditionalArguments().GetIterator()
+	var additionalArgumentsSize = uint(additionalArguments.GetSize())
+	for additionalArguments.HasNext() {
+		additionalArgumentIndex++
+		var additionalArgument = additionalArguments.GetNext()
+		v.processor_.PreprocessAdditionalArgument(
+			additionalArgument,
+			additionalArgumentIndex,
+			additionalArgumentsSize,
+		)
+		v.visitAdditionalArgument(additionalArgument)
+		v.processor_.PostprocessAdditionalArgument(
+			additionalArgument,
+			additionalArgumentIndex,
+			additionalArgumentsSize,
+		)
+	}
+}
+
 func (v *visitor_) visitTypeDefinition(typeDefinition ast.TypeDefinitionLike) {
 	// Visit the declaration rule.
 	var declaration = typeDefinition.GetDeclaration()
@@ -1131,11 +1777,11 @@ func (v *visitor_) visitTypeDefinition(typeDefinition ast.TypeDefinitionLike) {
 	// Visit slot 1 between references.
 	v.processor_.ProcessTypeDefinitionSlot(1)
 
-	// Visit the abstraction rule.
-	var abstraction = typeDefinition.GetAbstraction()
-	v.processor_.PreprocessAbstraction(abstraction)
-	v.visitAbstraction(abstraction)
-	v.processor_.PostprocessAbstraction(abstraction)
+	// Visit the underlying rule.
+	var underlying = typeDefinition.GetUnderlying()
+	v.processor_.PreprocessUnderlying(underlying)
+	v.visitUnderlying(underlying)
+	v.processor_.PostprocessUnderlying(underlying)
 
 	// Visit slot 2 between references.
 	v.processor_.ProcessTypeDefinitionSlot(2)
@@ -1171,10 +1817,41 @@ func (v *visitor_) visitTypeSection(typeSection ast.TypeSectionLike) {
 	}
 }
 
+func (v *visitor_) visitUnary(unary ast.UnaryLike) {
+	// Visit the operand rule.
+	var operand = unary.GetOperand()
+	v.processor_.PreprocessOperand(operand)
+	v.visitOperand(operand)
+	v.processor_.PostprocessOperand(operand)
+}
+
+func (v *visitor_) visitUnderlying(underlying ast.UnderlyingLike) {
+	// Visit the possible underlying types.
+	switch actual := underlying.GetAny().(type) {
+	case ast.StructureLike:
+		v.processor_.PreprocessStructure(actual)
+		v.visitStructure(actual)
+		v.processor_.PostprocessStructure(actual)
+	case ast.AbstractionLike:
+		v.processor_.PreprocessAbstraction(actual)
+		v.visitAbstraction(actual)
+		v.processor_.PostprocessAbstraction(actual)
+	case string:
+		switch {
+		default:
+			panic(fmt.Sprintf("Invalid token: %v", actual))
+		}
+	default:
+		panic(fmt.Sprintf("Invalid rule type: %T", actual))
+	}
+}
+
 func (v *visitor_) visitValue(value ast.ValueLike) {
-	// Visit the name token.
+	// Visit the name token or "_" delimiter.
 	var name = value.GetName()
-	v.processor_.ProcessName(name)
+	if name != "_" {
+		v.processor_.ProcessName(name)
+	}
 
 	// Visit slot 1 between references.
 	v.processor_.ProcessValueSlot(1)
@@ -1184,6 +1861,15 @@ func (v *visitor_) visitValue(value ast.ValueLike) {
 	v.processor_.PreprocessAbstraction(abstraction)
 	v.visitAbstraction(abstraction)
 	v.processor_.PostprocessAbstraction(abstraction)
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessValueSlot(2)
+
+	// Visit the initializer rule.
+	var initializer = value.GetInitializer()
+	v.processor_.PreprocessInitializer(initializer)
+	v.visitInitializer(initializer)
+	v.processor_.PostprocessInitializer(initializer)
 }
 
 // PRIVATE INTERFACE
@@ -0,0 +1,143 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package grammar
+
+import (
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	uti "github.com/craterdog/go-missing-utilities/v2"
+	ast "github.com/craterdog/go-model-framework/v4/ast"
+	reg "regexp"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func Workspace() WorkspaceClassLike {
+	return workspaceReference()
+}
+
+// Constructor Methods
+
+func (c *workspaceClass_) Make(
+	modulePath string,
+	models abs.CatalogLike[string, ast.ModelLike],
+) WorkspaceLike {
+	if uti.IsUndefined(modulePath) {
+		panic("The \"modulePath\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(models) {
+		panic("The \"models\" attribute is required by this class.")
+	}
+	var instance = &workspace_{
+		// Initialize the instance attributes.
+		modulePath_: modulePath,
+		models_:     models,
+	}
+	return instance
+}
+
+// Function Methods
+
+func (c *workspaceClass_) ModuleName(
+	module ast.ModuleLike,
+	optionalWorkspace WorkspaceLike,
+) string {
+	var result_ string
+	var alias = module.GetOptionalAlias()
+	if uti.IsDefined(alias) {
+		result_ = alias.GetAny().(string)
+		return result_
+	}
+
+	// A package in the workspace is named by its package declaration.
+	var modulePath = sts.Trim(module.GetPath(), "\"")
+	if uti.IsDefined(optionalWorkspace) {
+		var model = optionalWorkspace.GetModel(modulePath)
+		if uti.IsDefined(model) {
+			result_ = model.GetModuleDefinition().GetHeader().GetName()
+			return result_
+		}
+	}
+
+	// A standard library package is named by the last element of its path,
+	// ignoring any major version element.
+	var elements = sts.Split(modulePath, "/")
+	if !sts.Contains(elements[0], ".") {
+		result_ = elements[len(elements)-1]
+		if len(elements) > 1 && c.majorVersion_.MatchString(result_) {
+			result_ = elements[len(elements)-2]
+		}
+	}
+	return result_
+}
+
+// INSTANCE INTERFACE
+
+// Attribute Methods
+
+func (v *workspace_) GetModulePath() string {
+	return v.modulePath_
+}
+
+func (v *workspace_) GetModels() abs.CatalogLike[string, ast.ModelLike] {
+	return v.models_
+}
+
+// Public Methods
+
+func (v *workspace_) GetClass() WorkspaceClassLike {
+	return v.getClass()
+}
+
+func (v *workspace_) GetModel(
+	packagePath string,
+) ast.ModelLike {
+	var result_ = v.models_.GetValue(packagePath)
+	return result_
+}
+
+// Private Methods
+
+func (v *workspace_) getClass() *workspaceClass_ {
+	return workspaceReference()
+}
+
+// PRIVATE INTERFACE
+
+// Instance Structure
+
+type workspace_ struct {
+	// Declare the instance attributes.
+	modulePath_ string
+	models_     abs.CatalogLike[string, ast.ModelLike]
+}
+
+// Class Structure
+
+type workspaceClass_ struct {
+	// Declare the class constants.
+	majorVersion_ *reg.Regexp
+}
+
+// Class Reference
+
+func workspaceReference() *workspaceClass_ {
+	return workspaceReference_
+}
+
+var workspaceReference_ = &workspaceClass_{
+	// Initialize the class constants.
+	majorVersion_: reg.MustCompile("^v[0-9]+$"),
+}